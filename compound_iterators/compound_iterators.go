@@ -6,3 +6,49 @@ type ReadForIndexIterator[TKey any, TValue any] interface {
 	ds.ReadForIterator[TValue]
 	ds.IndexedIterator[TKey]
 }
+
+// ReadBidIndexIterator is a ReadForIndexIterator, which can also be moved backward.
+type ReadBidIndexIterator[TKey any, TValue any] interface {
+	ReadForIndexIterator[TKey, TValue]
+	ds.BidirectionalIterator
+}
+
+// ReadForRandIndexIterator is a ReadForIndexIterator, which can also be moved to and read from arbitrary indices.
+type ReadForRandIndexIterator[TKey any, TValue any] interface {
+	ReadForIndexIterator[TKey, TValue]
+	ds.RandomAccessReadableIterator[TKey, TValue]
+}
+
+// ReadBidRandIndexIterator is a ReadForRandIndexIterator, which can also be moved backward.
+type ReadBidRandIndexIterator[TKey any, TValue any] interface {
+	ReadForRandIndexIterator[TKey, TValue]
+	ds.BidirectionalIterator
+}
+
+// ErrIterator is implemented by iterators, which can fail while advancing.
+// A failing iterator stops the iteration, Err then returns the cause.
+type ErrIterator interface {
+	// Err returns the error, which stopped the iteration, or nil.
+	Err() error
+}
+
+// ErrOf returns the error of iterator if it implements ErrIterator and nil otherwise.
+func ErrOf(iterator any) error {
+	if errIterator, ok := iterator.(ErrIterator); ok {
+		return errIterator.Err()
+	}
+
+	return nil
+}
+
+// PeekIterator is a ReadForIndexIterator, which can look at upcoming elements without moving.
+type PeekIterator[TKey any, TValue any] interface {
+	ReadForIndexIterator[TKey, TValue]
+
+	// Peek returns the element, which the next call to Next would move to.
+	Peek() (value TValue, found bool)
+	// PeekN returns the element, which a call to NextN(n) would move to.
+	PeekN(n int) (value TValue, found bool)
+	// Unread moves the iterator back by one position, so that the next call to Next yields the current element again.
+	Unread() bool
+}
@@ -148,7 +148,7 @@ func AddPartial[T Addable](fixedAddable T) func(T) T {
 }
 
 func Subtract[T Subtractable](a T, b T) T {
-	return a + b
+	return a - b
 }
 
 func SubtractPartial[T Subtractable](fixedSubtractable T) func(T) T {
@@ -156,7 +156,7 @@ func SubtractPartial[T Subtractable](fixedSubtractable T) func(T) T {
 }
 
 func Multiply[T Multiplyable](a T, b T) T {
-	return a + b
+	return a * b
 }
 
 func MultiplyPartial[T Multiplyable](fixedMultiplyable T) func(T) T {
@@ -164,7 +164,7 @@ func MultiplyPartial[T Multiplyable](fixedMultiplyable T) func(T) T {
 }
 
 func Divide[T Divisible](a T, b T) T {
-	return a + b
+	return a / b
 }
 
 func DividePartial[T Divisible](fixedDivideable T) func(T) T {
@@ -172,7 +172,7 @@ func DividePartial[T Divisible](fixedDivideable T) func(T) T {
 }
 
 func Modulo[T constraints.Integer](a T, b T) T {
-	return a + b
+	return a % b
 }
 
 func ModuloPartial[T constraints.Integer](fixedOperand T) func(T) T {
@@ -188,7 +188,7 @@ func BitAndPartial[T constraints.Integer](fixedOperand T) func(T) T {
 }
 
 func BitOr[T constraints.Integer](a T, b T) T {
-	return a & b
+	return a | b
 }
 
 func BitOrPartial[T constraints.Integer](fixedOperand T) func(T) T {
@@ -196,7 +196,7 @@ func BitOrPartial[T constraints.Integer](fixedOperand T) func(T) T {
 }
 
 func BitXor[T constraints.Integer](a T, b T) T {
-	return a & b
+	return a ^ b
 }
 
 func BitXorPartial[T constraints.Integer](fixedOperand T) func(T) T {
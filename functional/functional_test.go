@@ -0,0 +1,37 @@
+package functional_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/goaoi/functional"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ArithmeticOperators(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name     string
+		operator func(int, int) int
+		partial  func(int) func(int) int
+		a        int
+		b        int
+		exp      int
+	}{
+		{name: "subtract", operator: functional.Subtract[int], partial: functional.SubtractPartial[int], a: 12, b: 5, exp: 7},
+		{name: "multiply", operator: functional.Multiply[int], partial: functional.MultiplyPartial[int], a: 12, b: 5, exp: 60},
+		{name: "divide", operator: functional.Divide[int], partial: functional.DividePartial[int], a: 12, b: 5, exp: 2},
+		{name: "modulo", operator: functional.Modulo[int], partial: functional.ModuloPartial[int], a: 12, b: 5, exp: 2},
+		{name: "bit or", operator: functional.BitOr[int], partial: functional.BitOrPartial[int], a: 12, b: 5, exp: 13},
+		{name: "bit xor", operator: functional.BitXor[int], partial: functional.BitXorPartial[int], a: 12, b: 6, exp: 10},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equalf(t, tc.exp, tc.operator(tc.a, tc.b), tc.name+", operator")
+			assert.Equalf(t, tc.exp, tc.partial(tc.b)(tc.a), tc.name+", partial")
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package generators
+
+import (
+	"math"
+	"math/big"
+
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+const (
+	ErrorNegativeLength = "length of generated values must not be negative"
+)
+
+// Combinatorics lazily generates arrangements of the elements of a slice.
+// Each arrangement is described by indices into the slice, which are advanced by an algorithm specific function.
+// Every generated value is a newly allocated slice, so it can be kept by the caller.
+type Combinatorics[TValue any] struct {
+	values  []TValue
+	indices []int
+	advance func(indices []int, n int) bool
+	index   int
+	size    int
+	done    bool
+}
+
+func newCombinatorics[TValue any](values []TValue, indices []int, size int, advance func([]int, int) bool) compounditerators.ReadForIndexIterator[int, []TValue] {
+	return &Combinatorics[TValue]{
+		values:  values,
+		indices: indices,
+		advance: advance,
+		index:   -1,
+		size:    size,
+	}
+}
+
+// NewPermutations generates all len(values)! orderings of values.
+// The orderings are generated in lexicographic order of the element positions, equal elements are not deduplicated.
+// If len(values)! does not fit into an int, Size() returns -1.
+func NewPermutations[TValue any](values []TValue) compounditerators.ReadForIndexIterator[int, []TValue] {
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	size := sizeOf(new(big.Int).MulRange(1, int64(len(values))))
+
+	return newCombinatorics(values, indices, size, nextPermutation)
+}
+
+// NewCombinations generates all binomial(len(values), k) selections of k elements from values, keeping their relative order.
+// If the number of selections does not fit into an int, Size() returns -1.
+// Panics with ErrorNegativeLength if k < 0.
+func NewCombinations[TValue any](values []TValue, k int) compounditerators.ReadForIndexIterator[int, []TValue] {
+	if k < 0 {
+		panic(ErrorNegativeLength)
+	}
+
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	return newCombinatorics(values, indices, binomial(len(values), k), nextCombination)
+}
+
+// NewCombinationsWithReplacement generates all binomial(len(values) + k - 1, k) selections of k elements from values,
+// where each element can be selected multiple times.
+// If the number of selections does not fit into an int, Size() returns -1.
+// Panics with ErrorNegativeLength if k < 0.
+func NewCombinationsWithReplacement[TValue any](values []TValue, k int) compounditerators.ReadForIndexIterator[int, []TValue] {
+	if k < 0 {
+		panic(ErrorNegativeLength)
+	}
+
+	size := 1
+	if k > 0 {
+		size = binomial(len(values)+k-1, k)
+	}
+
+	return newCombinatorics(values, make([]int, k), size, nextCombinationWithReplacement)
+}
+
+func binomial(n int, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	return sizeOf(new(big.Int).Binomial(int64(n), int64(k)))
+}
+
+// sizeOf converts count to an int, it returns -1 if count does not fit.
+func sizeOf(count *big.Int) int {
+	if !count.IsInt64() || count.Int64() > math.MaxInt {
+		return -1
+	}
+
+	return int(count.Int64())
+}
+
+func nextPermutation(indices []int, n int) bool {
+	i := len(indices) - 2
+	for i >= 0 && indices[i] > indices[i+1] {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	j := len(indices) - 1
+	for indices[j] < indices[i] {
+		j--
+	}
+
+	indices[i], indices[j] = indices[j], indices[i]
+
+	for l, r := i+1, len(indices)-1; l < r; l, r = l+1, r-1 {
+		indices[l], indices[r] = indices[r], indices[l]
+	}
+
+	return true
+}
+
+func nextCombination(indices []int, n int) bool {
+	k := len(indices)
+
+	i := k - 1
+	for i >= 0 && indices[i] == i+n-k {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	indices[i]++
+	for j := i + 1; j < k; j++ {
+		indices[j] = indices[j-1] + 1
+	}
+
+	return true
+}
+
+func nextCombinationWithReplacement(indices []int, n int) bool {
+	i := len(indices) - 1
+	for i >= 0 && indices[i] == n-1 {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	indices[i]++
+	for j := i + 1; j < len(indices); j++ {
+		indices[j] = indices[i]
+	}
+
+	return true
+}
+
+func (it *Combinatorics[TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Combinatorics[TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Combinatorics[TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Combinatorics[TValue]) IsLast() bool {
+	return it.index == it.size-1
+}
+
+func (it *Combinatorics[TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Combinatorics[TValue]) Get() (value []TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	value = make([]TValue, len(it.indices))
+	for i, index := range it.indices {
+		value[i] = it.values[index]
+	}
+
+	return value, true
+}
+
+func (it *Combinatorics[TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+func (it *Combinatorics[TValue]) Next() bool {
+	if it.IsEnd() {
+		return false
+	}
+
+	if it.size == 0 || !it.IsBegin() && !it.advance(it.indices, len(it.values)) {
+		it.done = true
+
+		return false
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *Combinatorics[TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Combinatorics[TValue]) Size() int {
+	return it.size
+}
+
+func (it *Combinatorics[TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
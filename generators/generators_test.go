@@ -104,3 +104,139 @@ func Test_RepeatGenerator(t *testing.T) {
 		})
 	}
 }
+
+func Test_PermutationsGenerator(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name   string
+		values []int
+		output [][]int
+	}{
+		{name: "empty", values: []int{}, output: [][]int{{}}},
+		{name: "one value", values: []int{1}, output: [][]int{{1}}},
+		{name: "three values", values: []int{1, 2, 3}, output: [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}},
+		{name: "equal values", values: []int{1, 1}, output: [][]int{{1, 1}, {1, 1}}},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, tc.name)
+
+			out := generators.NewPermutations(tc.values)
+			result := arraylist.NewFromIterator[[]int](out).GetSlice()
+
+			assert.Equalf(t, tc.output, result, tc.name+", output")
+			assert.Equalf(t, len(tc.output), out.Size(), tc.name+", size")
+		})
+	}
+}
+
+func Test_CombinationsGenerator(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name   string
+		values []int
+		k      int
+		output [][]int
+		error  string
+	}{
+		{name: "k is 0", values: []int{1, 2}, k: 0, output: [][]int{{}}},
+		{name: "k greater than values", values: []int{1, 2}, k: 3, output: [][]int{}},
+		{name: "k is 2", values: []int{1, 2, 3, 4}, k: 2, output: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{name: "k equals values", values: []int{1, 2, 3}, k: 3, output: [][]int{{1, 2, 3}}},
+		{name: "negative k", values: []int{1, 2, 3}, k: -1, output: [][]int{}, error: generators.ErrorNegativeLength},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, tc.name)
+
+			if tc.error != "" {
+				assert.PanicsWithValuef(t, tc.error, func() {
+					generators.NewCombinations(tc.values, tc.k)
+				}, tc.name+", construction error")
+			} else {
+				out := generators.NewCombinations(tc.values, tc.k)
+				result := arraylist.NewFromIterator[[]int](out).GetSlice()
+
+				assert.Equalf(t, tc.output, result, tc.name+", output")
+				assert.Equalf(t, len(tc.output), out.Size(), tc.name+", size")
+			}
+		})
+	}
+}
+
+func Test_CombinationsWithReplacementGenerator(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name   string
+		values []int
+		k      int
+		output [][]int
+		error  string
+	}{
+		{name: "k is 0", values: []int{1, 2}, k: 0, output: [][]int{{}}},
+		{name: "no values", values: []int{}, k: 2, output: [][]int{}},
+		{name: "k is 2", values: []int{1, 2, 3}, k: 2, output: [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}},
+		{name: "k greater than values", values: []int{1, 2}, k: 3, output: [][]int{{1, 1, 1}, {1, 1, 2}, {1, 2, 2}, {2, 2, 2}}},
+		{name: "negative k", values: []int{1, 2, 3}, k: -1, output: [][]int{}, error: generators.ErrorNegativeLength},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, tc.name)
+
+			if tc.error != "" {
+				assert.PanicsWithValuef(t, tc.error, func() {
+					generators.NewCombinationsWithReplacement(tc.values, tc.k)
+				}, tc.name+", construction error")
+			} else {
+				out := generators.NewCombinationsWithReplacement(tc.values, tc.k)
+				result := arraylist.NewFromIterator[[]int](out).GetSlice()
+
+				assert.Equalf(t, tc.output, result, tc.name+", output")
+				assert.Equalf(t, len(tc.output), out.Size(), tc.name+", size")
+			}
+		})
+	}
+}
+
+func Test_CombinatoricsGeneratorSizeOverflow(t *testing.T) {
+	t.Parallel()
+
+	values := func(n int) []int {
+		return make([]int, n)
+	}
+
+	tcs := []struct {
+		name string
+		out  interface {
+			Size() int
+			Next() bool
+		}
+		size int
+	}{
+		{name: "permutations fitting", out: generators.NewPermutations(values(20)), size: 2432902008176640000},
+		{name: "permutations overflowing", out: generators.NewPermutations(values(21)), size: -1},
+		{name: "permutations wrapping to zero", out: generators.NewPermutations(values(66)), size: -1},
+		{name: "combinations fitting", out: generators.NewCombinations(values(66), 33), size: 7219428434016265740},
+		{name: "combinations overflowing", out: generators.NewCombinations(values(67), 33), size: -1},
+		{name: "combinations with replacement fitting", out: generators.NewCombinationsWithReplacement(values(34), 33), size: 7219428434016265740},
+		{name: "combinations with replacement overflowing", out: generators.NewCombinationsWithReplacement(values(35), 33), size: -1},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equalf(t, tc.size, tc.out.Size(), tc.name+", size")
+			assert.Truef(t, tc.out.Next(), tc.name+", next")
+		})
+	}
+}
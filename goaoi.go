@@ -4,10 +4,18 @@ package goaoi
 
 import (
 	"bytes"
+	"math/rand"
+	"reflect"
+	"unicode/utf8"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+	"github.com/JonasMuehlmann/goaoi/functional"
 	iteratoradapters "github.com/JonasMuehlmann/goaoi/iterator_adapters"
+	"github.com/barweiss/go-tuple"
 	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
 )
 
 // FindIfMap finds the first key where unaryPredicate(haystack[key]) == true.
@@ -92,6 +100,55 @@ func FindIfIterator[TKey any, TValue comparable](haystack ds.ReadForIndexIterato
 	return 0, ElementNotFoundError{}
 }
 
+// FindLastIfSlice finds the last index i where unaryPredicate(haystack[i]) == true.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func FindLastIfSlice[T comparable](haystack []T, unaryPredicate func(T) bool) (int, error) {
+	if len(haystack) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := len(haystack) - 1; i >= 0; i-- {
+		if unaryPredicate(haystack[i]) {
+			return i, nil
+		}
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+// FindLastIfIterator finds the last index i where unaryPredicate(haystack[i]) == true.
+// The elements are visited from the last to the first one, on success haystack is left at the found element.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+//   - ExecutionError
+func FindLastIfIterator[TKey any, TValue comparable](haystack compounditerators.ReadBidIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool) (int, error) {
+	if haystack.IsEnd() {
+		return 0, EmptyIterableError{}
+	}
+
+	reversed := iteratoradapters.NewReverse(haystack)
+
+	for reversed.Next() {
+		i, _ := haystack.Index()
+
+		value, found := reversed.Get()
+		if !found {
+			return 0, getError[TValue](haystack, i)
+		}
+
+		if unaryPredicate(value) {
+			return i, nil
+		}
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
 // FindEndSlicePred finds the beginning of the last occurrence of sub in super.
 // The elements are compared with binary_predicate.
 //
@@ -465,6 +522,27 @@ func ForeachSlice[T any](container []T, unary_func func(T) error) error {
 	return nil
 }
 
+// ForeachReverseSlice executes unary_func(val) for each val in container, starting with the last one.
+// Errors returned by unary_func are propagated to the caller of ForeachReverseSlice.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func ForeachReverseSlice[T any](container []T, unary_func func(T) error) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for i := len(container) - 1; i >= 0; i-- {
+		err := unary_func(container[i])
+		if err != nil {
+			return ExecutionError[int, T]{BadItemIndex: i, BadItem: container[i], Inner: err}
+		}
+	}
+
+	return nil
+}
+
 // ForeachMap executes unary_func(val) for each val in container.
 // Note that the iteration order of a map is not stable.
 // Errors returned by unary_func are propagated to the caller of ForeachMap.
@@ -510,6 +588,7 @@ func ForeachString(container string, unary_func func(rune) error) error {
 
 // ForeachIterator executes unary_func(val) for each val in container.
 // Errors returned by unary_func are propagated to the caller of ForeachSlice.
+// If container implements compounditerators.ErrIterator, an error stopping the iteration is propagated as well.
 //
 // Possible Error values:
 //   - EmptyIterableError
@@ -519,8 +598,13 @@ func ForeachIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKe
 		return EmptyIterableError{}
 	}
 
-	for container.Next() {
-		value, _ := container.Get()
+	n := 0
+	for ; container.Next(); n++ {
+		value, found := container.Get()
+		if !found {
+			return getError[TValue](container, n)
+		}
+
 		err := unary_func(value)
 		if err != nil {
 			i, _ := container.Index()
@@ -528,7 +612,7 @@ func ForeachIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKe
 		}
 	}
 
-	return nil
+	return iteratorError[TValue](container, n)
 }
 
 // ForeachSliceUnsafe executes unary_func(val) for each val in container.
@@ -582,7 +666,7 @@ func ForeachStringUnsafe(container string, unary_func func(rune)) error {
 }
 
 // ForeachIteratorUnsafe executes unary_func(val) for each val in container.
-// Errors returned by unary_func are propagated to the caller of ForeachSlice.
+// If container implements compounditerators.ErrIterator, an error stopping the iteration is propagated to the caller.
 //
 // Possible Error values:
 //   - EmptyIterableError
@@ -592,12 +676,17 @@ func ForeachIteratorUnsafe[TKey any, TValue any](container ds.ReadForIndexIterat
 		return EmptyIterableError{}
 	}
 
-	for container.Next() {
-		value, _ := container.Get()
+	n := 0
+	for ; container.Next(); n++ {
+		value, found := container.Get()
+		if !found {
+			return getError[TValue](container, n)
+		}
+
 		unary_func(value)
 	}
 
-	return nil
+	return iteratorError[TValue](container, n)
 }
 
 // CountIfSlice counts for how many val of container unaryPredicate(val) == true.
@@ -659,23 +748,30 @@ func CountIfString(container string, unaryPredicate func(rune) bool) (int, error
 }
 
 // CountIfIterator counts for how many val of container unaryPredicate(val) == true.
+// If container implements compounditerators.ErrIterator, an error stopping the iteration is propagated alongside the count up to that point.
 //
 // Possible Error values:
 //   - EmptyIterableError
+//   - ExecutionError
 func CountIfIterator[TKey any, TValue comparable](container ds.ReadForIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool) (int, error) {
 	if container.IsEnd() {
 		return 0, EmptyIterableError{}
 	}
 
 	counter := 0
-	for container.Next() {
-		value, _ := container.Get()
+	n := 0
+	for ; container.Next(); n++ {
+		value, found := container.Get()
+		if !found {
+			return counter, getError[TValue](container, n)
+		}
+
 		if unaryPredicate(value) {
 			counter++
 		}
 	}
 
-	return counter, nil
+	return counter, iteratorError[TValue](container, n)
 }
 
 // MismatchSlicePred finds the first index i where binary_predicate(iterable1[i], iterable2[i] == false).
@@ -965,6 +1061,33 @@ func StridedIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey
 	return iteratoradapters.NewStrided[TKey, TValue](original, n), nil
 }
 
+// PeekableIterator returns a copy of original, which allows looking at upcoming elements without moving.
+// Passing it to TakeWhileIterator leaves the first element not satisfying the predicate unconsumed.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PeekableIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue]) (compounditerators.PeekIterator[TKey, TValue], error) {
+	peekable := iteratoradapters.NewPeekable(original)
+
+	if original.IsEnd() {
+		return peekable, EmptyIterableError{}
+	}
+
+	return peekable, nil
+}
+
+// ReverseIterator returns a copy of original yielding its elements from the last to the first one.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ReverseIterator[TKey any, TValue any](original compounditerators.ReadBidIndexIterator[TKey, TValue]) (compounditerators.ReadBidIndexIterator[TKey, TValue], error) {
+	if original.IsEnd() {
+		return original, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewReverse(original), nil
+}
+
 // JoinIterator returns a copy of original including every element of every iterator.
 //
 // Possible Error values:
@@ -1660,12 +1783,2557 @@ func AccumulateString(container string, initialAccumulator rune, binary_func fun
 }
 
 // AccumulateIterator returns initialAccumulator after executing initialAccumulaator = binary_func(initialAccumulator, element) for each element.
-func AccumulateIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TValue, binary_func func(TValue, TValue) TValue) TValue {
-	for container.Next() {
-		value, _ := container.Get()
+// If container implements compounditerators.ErrIterator, an error stopping the iteration is propagated alongside the accumulator up to that point.
+//
+// Possible Error values:
+//   - ExecutionError
+func AccumulateIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TValue, binary_func func(TValue, TValue) TValue) (TValue, error) {
+	n := 0
+	for ; container.Next(); n++ {
+		value, found := container.Get()
+		if !found {
+			return initialAccumulator, getError[TValue](container, n)
+		}
+
 		initialAccumulator = binary_func(initialAccumulator, value)
+	}
+
+	return initialAccumulator, iteratorError[TValue](container, n)
+}
 
+// SortSlicePred sorts container in place.
+// The elements are compared with binary_predicate, which should report whether its first argument is ordered before its second.
+// Note that the sort is not stable.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SortSlicePred[T any](container []T, binary_predicate func(T, T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
 	}
 
-	return initialAccumulator
+	slices.SortFunc(container, binary_predicate)
+
+	return nil
+}
+
+// StableSortSlicePred sorts container in place while keeping the order of equal elements.
+// The elements are compared with binary_predicate, which should report whether its first argument is ordered before its second.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func StableSortSlicePred[T any](container []T, binary_predicate func(T, T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	slices.SortStableFunc(container, binary_predicate)
+
+	return nil
+}
+
+// PartialSortSlicePred rearranges container in place so that the range [0, n[ contains the n smallest elements in sorted order.
+// The order of the remaining elements is unspecified.
+// If n >= len(container), the whole container is sorted.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartialSortSlicePred[T any](container []T, n int, binary_predicate func(T, T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	if n >= len(container) {
+		slices.SortFunc(container, binary_predicate)
+
+		return nil
+	}
+
+	nthElement(container, n-1, binary_predicate)
+	slices.SortFunc(container[:n], binary_predicate)
+
+	return nil
+}
+
+// PartialSortCopySlicePred returns a sorted copy of the up to n smallest elements of original.
+// original is left unchanged.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartialSortCopySlicePred[T any](original []T, n int, binary_predicate func(T, T) bool) ([]T, error) {
+	if len(original) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	newContainer := make([]T, len(original))
+	copy(newContainer, original)
+
+	n = min(utils.Max(n, 0), len(newContainer))
+
+	// Cannot fail, newContainer is not empty
+	_ = PartialSortSlicePred(newContainer, n, binary_predicate)
+
+	return newContainer[:n:n], nil
+}
+
+// NthElementSlicePred rearranges container in place so that container[n] holds the element which would be there if container was sorted.
+// All elements before n are not ordered after container[n] and all elements after n are not ordered before it.
+// If n is not a valid index, container is left unchanged.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func NthElementSlicePred[T any](container []T, n int, binary_predicate func(T, T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	if n < 0 || n >= len(container) {
+		return nil
+	}
+
+	nthElement(container, n, binary_predicate)
+
+	return nil
+}
+
+// IsSortedSlicePred checks if container is sorted.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IsSortedSlicePred[T any](container []T, binary_predicate func(T, T) bool) error {
+	_, err := IsSortedUntilSlicePred(container, binary_predicate)
+
+	return err
+}
+
+// IsSortedUntilSlicePred finds the end of the longest sorted prefix of container.
+// If container is not fully sorted, the index of the first out of order element is returned alongside a ComparisonError.
+// Otherwise, len(container) is returned.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IsSortedUntilSlicePred[T any](container []T, binary_predicate func(T, T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := 1; i < len(container); i++ {
+		if binary_predicate(container[i], container[i-1]) {
+			return i, ComparisonError[int, T]{BadItemIndex: i, BadItem: container[i]}
+		}
+	}
+
+	return len(container), nil
+}
+
+// LowerBoundSlicePred finds the first index i where binary_predicate(haystack[i], needle) == false.
+// haystack must be partitioned with respect to binary_predicate(element, needle), which is the case if it is sorted by binary_predicate.
+// If no such index exists, len(haystack) is returned alongside an ElementNotFoundError, which is the position needle would need to be inserted at.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func LowerBoundSlicePred[T any](haystack []T, needle T, binary_predicate func(T, T) bool) (int, error) {
+	if len(haystack) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := partitionPointIndex(len(haystack), func(i int) bool { return binary_predicate(haystack[i], needle) })
+	if i == len(haystack) {
+		return i, ElementNotFoundError{}
+	}
+
+	return i, nil
+}
+
+// UpperBoundSlicePred finds the first index i where binary_predicate(needle, haystack[i]) == true.
+// haystack must be partitioned with respect to binary_predicate(needle, element), which is the case if it is sorted by binary_predicate.
+// If no such index exists, len(haystack) is returned alongside an ElementNotFoundError.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func UpperBoundSlicePred[T any](haystack []T, needle T, binary_predicate func(T, T) bool) (int, error) {
+	if len(haystack) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := partitionPointIndex(len(haystack), func(i int) bool { return !binary_predicate(needle, haystack[i]) })
+	if i == len(haystack) {
+		return i, ElementNotFoundError{}
+	}
+
+	return i, nil
+}
+
+// EqualRangeSlicePred finds the range [first, last[ of elements equivalent to needle in the sorted haystack.
+// If there are no equivalent elements, the empty range at the position needle would need to be inserted at is returned alongside an ElementNotFoundError.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func EqualRangeSlicePred[T any](haystack []T, needle T, binary_predicate func(T, T) bool) (int, int, error) {
+	if len(haystack) == 0 {
+		return 0, 0, EmptyIterableError{}
+	}
+
+	first := partitionPointIndex(len(haystack), func(i int) bool { return binary_predicate(haystack[i], needle) })
+	last := first + partitionPointIndex(len(haystack)-first, func(i int) bool { return !binary_predicate(needle, haystack[first+i]) })
+
+	if first == last {
+		return first, last, ElementNotFoundError{}
+	}
+
+	return first, last, nil
+}
+
+// BinarySearchSlicePred finds the first index i of an element equivalent to needle in the sorted haystack.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func BinarySearchSlicePred[T any](haystack []T, needle T, binary_predicate func(T, T) bool) (int, error) {
+	i, err := LowerBoundSlicePred(haystack, needle, binary_predicate)
+	if err != nil {
+		return 0, err
+	}
+
+	if binary_predicate(needle, haystack[i]) {
+		return 0, ElementNotFoundError{}
+	}
+
+	return i, nil
+}
+
+// LowerBoundIteratorPred finds the first index i where binary_predicate(haystack[i], needle) == false.
+// The search takes O(log(n)) steps by accessing elements through haystack.GetAt().
+// Only random read access is needed, so the random access adapters of package iteratoradapters can be searched as well.
+// On success, haystack is moved to the found index.
+// If no such index exists, haystack.Size() is returned alongside an ElementNotFoundError and haystack is not moved.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func LowerBoundIteratorPred[TKey any, TValue any](haystack ds.RandomAccessReadableIterator[TKey, TValue], needle TValue, binary_predicate func(TValue, TValue) bool) (int, error) {
+	if haystack.Size() <= 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := partitionPointIndex(haystack.Size(), func(i int) bool {
+		value, _ := haystack.GetAt(i)
+
+		return binary_predicate(value, needle)
+	})
+	if i == haystack.Size() {
+		return i, ElementNotFoundError{}
+	}
+
+	haystack.MoveTo(i)
+
+	return i, nil
+}
+
+// UpperBoundIteratorPred finds the first index i where binary_predicate(needle, haystack[i]) == true.
+// The search takes O(log(n)) steps by accessing elements through haystack.GetAt().
+// On success, haystack is moved to the found index.
+// If no such index exists, haystack.Size() is returned alongside an ElementNotFoundError and haystack is not moved.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func UpperBoundIteratorPred[TKey any, TValue any](haystack ds.RandomAccessReadableIterator[TKey, TValue], needle TValue, binary_predicate func(TValue, TValue) bool) (int, error) {
+	if haystack.Size() <= 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := partitionPointIndex(haystack.Size(), func(i int) bool {
+		value, _ := haystack.GetAt(i)
+
+		return !binary_predicate(needle, value)
+	})
+	if i == haystack.Size() {
+		return i, ElementNotFoundError{}
+	}
+
+	haystack.MoveTo(i)
+
+	return i, nil
+}
+
+// EqualRangeIteratorPred finds the range [first, last[ of elements equivalent to needle in the sorted haystack.
+// The search takes O(log(n)) steps by accessing elements through haystack.GetAt().
+// On success, haystack is moved to first.
+// If there are no equivalent elements, the empty range at the position needle would need to be inserted at is returned alongside an ElementNotFoundError.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func EqualRangeIteratorPred[TKey any, TValue any](haystack ds.RandomAccessReadableIterator[TKey, TValue], needle TValue, binary_predicate func(TValue, TValue) bool) (int, int, error) {
+	if haystack.Size() <= 0 {
+		return 0, 0, EmptyIterableError{}
+	}
+
+	first := partitionPointIndex(haystack.Size(), func(i int) bool {
+		value, _ := haystack.GetAt(i)
+
+		return binary_predicate(value, needle)
+	})
+	last := first + partitionPointIndex(haystack.Size()-first, func(i int) bool {
+		value, _ := haystack.GetAt(first + i)
+
+		return !binary_predicate(needle, value)
+	})
+
+	if first == last {
+		return first, last, ElementNotFoundError{}
+	}
+
+	haystack.MoveTo(first)
+
+	return first, last, nil
+}
+
+// BinarySearchIteratorPred finds the first index i of an element equivalent to needle in the sorted haystack.
+// The search takes O(log(n)) steps by accessing elements through haystack.GetAt().
+// On success, haystack is moved to the found index, otherwise it might be moved to the position needle would need to be inserted at.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func BinarySearchIteratorPred[TKey any, TValue any](haystack ds.RandomAccessReadableIterator[TKey, TValue], needle TValue, binary_predicate func(TValue, TValue) bool) (int, error) {
+	i, err := LowerBoundIteratorPred(haystack, needle, binary_predicate)
+	if err != nil {
+		return 0, err
+	}
+
+	value, _ := haystack.GetAt(i)
+	if binary_predicate(needle, value) {
+		return 0, ElementNotFoundError{}
+	}
+
+	return i, nil
+}
+
+// SetUnionSlicePred returns a sorted slice of all elements contained in iterable1 or iterable2, which both have to be sorted.
+// Equivalent elements contained in both are included max(n_iterable1, n_iterable2) times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetUnionSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) ([]T, error) {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	newContainer := make([]T, 0, len(iterable1)+len(iterable2))
+
+	i, j := 0, 0
+	for i < len(iterable1) && j < len(iterable2) {
+		if binary_predicate(iterable2[j], iterable1[i]) {
+			newContainer = append(newContainer, iterable2[j])
+			j++
+		} else {
+			if !binary_predicate(iterable1[i], iterable2[j]) {
+				j++
+			}
+
+			newContainer = append(newContainer, iterable1[i])
+			i++
+		}
+	}
+
+	newContainer = append(newContainer, iterable1[i:]...)
+	newContainer = append(newContainer, iterable2[j:]...)
+
+	return newContainer, nil
+}
+
+// SetIntersectionSlicePred returns a sorted slice of all elements contained in iterable1 and iterable2, which both have to be sorted.
+// Equivalent elements are included min(n_iterable1, n_iterable2) times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetIntersectionSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) ([]T, error) {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	newContainer := make([]T, 0, min(len(iterable1), len(iterable2)))
+
+	i, j := 0, 0
+	for i < len(iterable1) && j < len(iterable2) {
+		if binary_predicate(iterable1[i], iterable2[j]) {
+			i++
+		} else if binary_predicate(iterable2[j], iterable1[i]) {
+			j++
+		} else {
+			newContainer = append(newContainer, iterable1[i])
+			i++
+			j++
+		}
+	}
+
+	return newContainer, nil
+}
+
+// SetDifferenceSlicePred returns a sorted slice of all elements contained in iterable1 but not in iterable2, which both have to be sorted.
+// Equivalent elements are included max(n_iterable1 - n_iterable2, 0) times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetDifferenceSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) ([]T, error) {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	newContainer := make([]T, 0, len(iterable1))
+
+	i, j := 0, 0
+	for i < len(iterable1) && j < len(iterable2) {
+		if binary_predicate(iterable1[i], iterable2[j]) {
+			newContainer = append(newContainer, iterable1[i])
+			i++
+		} else if binary_predicate(iterable2[j], iterable1[i]) {
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+
+	newContainer = append(newContainer, iterable1[i:]...)
+
+	return newContainer, nil
+}
+
+// SetSymmetricDifferenceSlicePred returns a sorted slice of all elements contained in either iterable1 or iterable2, but not in both.
+// Both iterables have to be sorted.
+// Equivalent elements are included |n_iterable1 - n_iterable2| times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetSymmetricDifferenceSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) ([]T, error) {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	newContainer := make([]T, 0, len(iterable1)+len(iterable2))
+
+	i, j := 0, 0
+	for i < len(iterable1) && j < len(iterable2) {
+		if binary_predicate(iterable1[i], iterable2[j]) {
+			newContainer = append(newContainer, iterable1[i])
+			i++
+		} else if binary_predicate(iterable2[j], iterable1[i]) {
+			newContainer = append(newContainer, iterable2[j])
+			j++
+		} else {
+			i++
+			j++
+		}
+	}
+
+	newContainer = append(newContainer, iterable1[i:]...)
+	newContainer = append(newContainer, iterable2[j:]...)
+
+	return newContainer, nil
+}
+
+// IncludesSlicePred checks if every element of sub is contained in super, which both have to be sorted.
+// Equivalent elements in sub have to be contained at least as often in super.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IncludesSlicePred[T any](super []T, sub []T, binary_predicate func(T, T) bool) error {
+	if len(super) == 0 || len(sub) == 0 {
+		return EmptyIterableError{}
+	}
+
+	i, j := 0, 0
+	for j < len(sub) {
+		if i == len(super) || binary_predicate(sub[j], super[i]) {
+			return ComparisonError[int, T]{BadItemIndex: j, BadItem: sub[j]}
+		}
+
+		if !binary_predicate(super[i], sub[j]) {
+			j++
+		}
+
+		i++
+	}
+
+	return nil
+}
+
+// SetUnionIterator returns a lazy iterator yielding all elements contained in iterable1 or iterable2, which both have to be sorted.
+// Equivalent elements contained in both are yielded max(n_iterable1, n_iterable2) times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetUnionIterator[TKey any, TValue any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if iterable1.IsEnd() && iterable2.IsEnd() {
+		return iterable1, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewSetUnion[TKey, TValue](iterable1, iterable2, binary_predicate), nil
+}
+
+// SetIntersectionIterator returns a lazy iterator yielding all elements contained in iterable1 and iterable2, which both have to be sorted.
+// Equivalent elements are yielded min(n_iterable1, n_iterable2) times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetIntersectionIterator[TKey any, TValue any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if iterable1.IsEnd() && iterable2.IsEnd() {
+		return iterable1, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewSetIntersection[TKey, TValue](iterable1, iterable2, binary_predicate), nil
+}
+
+// SetDifferenceIterator returns a lazy iterator yielding all elements contained in iterable1 but not in iterable2, which both have to be sorted.
+// Equivalent elements are yielded max(n_iterable1 - n_iterable2, 0) times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetDifferenceIterator[TKey any, TValue any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if iterable1.IsEnd() && iterable2.IsEnd() {
+		return iterable1, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewSetDifference[TKey, TValue](iterable1, iterable2, binary_predicate), nil
+}
+
+// SetSymmetricDifferenceIterator returns a lazy iterator yielding all elements contained in either iterable1 or iterable2, but not in both.
+// Both iterables have to be sorted.
+// Equivalent elements are yielded |n_iterable1 - n_iterable2| times.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SetSymmetricDifferenceIterator[TKey any, TValue any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if iterable1.IsEnd() && iterable2.IsEnd() {
+		return iterable1, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewSetSymmetricDifference[TKey, TValue](iterable1, iterable2, binary_predicate), nil
+}
+
+// MergeSlicePred returns a sorted slice of all elements of iterable1 and iterable2, which both have to be sorted.
+// The merge is stable, equivalent elements of iterable1 are placed before those of iterable2.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func MergeSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) ([]T, error) {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	newContainer := make([]T, 0, len(iterable1)+len(iterable2))
+
+	i, j := 0, 0
+	for i < len(iterable1) && j < len(iterable2) {
+		if binary_predicate(iterable2[j], iterable1[i]) {
+			newContainer = append(newContainer, iterable2[j])
+			j++
+		} else {
+			newContainer = append(newContainer, iterable1[i])
+			i++
+		}
+	}
+
+	newContainer = append(newContainer, iterable1[i:]...)
+	newContainer = append(newContainer, iterable2[j:]...)
+
+	return newContainer, nil
+}
+
+// MergeIterator returns a lazy iterator yielding all elements of the sorted originals in sorted order.
+// The merge is stable, equivalent elements are yielded in the order of the originals they stem from.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func MergeIterator[TKey any, TValue any](binary_predicate func(TValue, TValue) bool, originals ...ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	empty := true
+	inner := make([]compounditerators.ReadForIndexIterator[TKey, TValue], 0, len(originals))
+
+	for _, original := range originals {
+		empty = empty && original.IsEnd()
+		inner = append(inner, original)
+	}
+
+	merged := iteratoradapters.NewMerge(binary_predicate, inner...)
+
+	if empty {
+		return merged, EmptyIterableError{}
+	}
+
+	return merged, nil
+}
+
+// MakeHeapSlicePred rearranges the elements of heap into a binary max heap.
+// The element for which binary_predicate(element, other) == false for all other elements is placed at (*heap)[0].
+// To build a min heap, pass a greater-than predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func MakeHeapSlicePred[T any](heap *[]T, binary_predicate func(T, T) bool) error {
+	if len(*heap) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for i := len(*heap)/2 - 1; i >= 0; i-- {
+		siftDown(*heap, i, len(*heap), binary_predicate)
+	}
+
+	return nil
+}
+
+// PushHeapSlicePred appends value to the binary max heap and restores the heap property.
+// The elements are compared with binary_predicate.
+func PushHeapSlicePred[T any](heap *[]T, value T, binary_predicate func(T, T) bool) {
+	*heap = append(*heap, value)
+
+	siftUp(*heap, len(*heap)-1, binary_predicate)
+}
+
+// PopHeapSlicePred removes the top element from the binary max heap, restores the heap property and returns the removed element.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PopHeapSlicePred[T any](heap *[]T, binary_predicate func(T, T) bool) (T, error) {
+	var zeroVal T
+
+	if len(*heap) == 0 {
+		return zeroVal, EmptyIterableError{}
+	}
+
+	last := len(*heap) - 1
+	top := (*heap)[0]
+
+	(*heap)[0] = (*heap)[last]
+	(*heap)[last] = zeroVal
+	*heap = (*heap)[:last]
+
+	siftDown(*heap, 0, last, binary_predicate)
+
+	return top, nil
+}
+
+// SortHeapSlicePred turns the binary max heap into a slice sorted in ascending order.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SortHeapSlicePred[T any](heap *[]T, binary_predicate func(T, T) bool) error {
+	if len(*heap) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for last := len(*heap) - 1; last > 0; last-- {
+		(*heap)[0], (*heap)[last] = (*heap)[last], (*heap)[0]
+		siftDown(*heap, 0, last, binary_predicate)
+	}
+
+	return nil
+}
+
+// IsHeapSlicePred checks if container is a binary max heap.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IsHeapSlicePred[T any](container []T, binary_predicate func(T, T) bool) error {
+	_, err := IsHeapUntilSlicePred(container, binary_predicate)
+
+	return err
+}
+
+// IsHeapUntilSlicePred finds the end of the longest prefix of container, which is a binary max heap.
+// If container is not fully a heap, the index of the first element violating the heap property is returned alongside a ComparisonError.
+// Otherwise, len(container) is returned.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IsHeapUntilSlicePred[T any](container []T, binary_predicate func(T, T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := 1; i < len(container); i++ {
+		if binary_predicate(container[(i-1)/2], container[i]) {
+			return i, ComparisonError[int, T]{BadItemIndex: i, BadItem: container[i]}
+		}
+	}
+
+	return len(container), nil
+}
+
+// NextPermutationSlicePred rearranges container into the next lexicographically greater permutation.
+// If container is already the greatest permutation, it is rearranged into the smallest one (sorted) and an ElementNotFoundError is returned.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func NextPermutationSlicePred[T any](container []T, binary_predicate func(T, T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	i := len(container) - 2
+	for i >= 0 && !binary_predicate(container[i], container[i+1]) {
+		i--
+	}
+
+	if i < 0 {
+		reverse(container)
+
+		return ElementNotFoundError{}
+	}
+
+	j := len(container) - 1
+	for !binary_predicate(container[i], container[j]) {
+		j--
+	}
+
+	container[i], container[j] = container[j], container[i]
+	reverse(container[i+1:])
+
+	return nil
+}
+
+// PrevPermutationSlicePred rearranges container into the next lexicographically smaller permutation.
+// If container is already the smallest permutation (sorted), it is rearranged into the greatest one and an ElementNotFoundError is returned.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func PrevPermutationSlicePred[T any](container []T, binary_predicate func(T, T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	i := len(container) - 2
+	for i >= 0 && !binary_predicate(container[i+1], container[i]) {
+		i--
+	}
+
+	if i < 0 {
+		reverse(container)
+
+		return ElementNotFoundError{}
+	}
+
+	j := len(container) - 1
+	for !binary_predicate(container[j], container[i]) {
+		j--
+	}
+
+	container[i], container[j] = container[j], container[i]
+	reverse(container[i+1:])
+
+	return nil
+}
+
+// IsPermutationSlicePred checks if iterable2 is a permutation of iterable1.
+// If an element of iterable1 occurs a different number of times in iterable2, a ComparisonError with it's index in iterable1 is returned.
+// If iterable2 contains additional elements, a ComparisonError with the index of the first one in iterable2 is returned.
+// The elements are compared with binary_predicate, which should report equality.
+// Note that this takes O(n^2) comparisons.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IsPermutationSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) error {
+	if len(iterable1) == 0 || len(iterable2) == 0 {
+		return EmptyIterableError{}
+	}
+
+	count := func(iterable []T, value T) int {
+		counter := 0
+		for _, other := range iterable {
+			if binary_predicate(value, other) {
+				counter++
+			}
+		}
+
+		return counter
+	}
+
+	for i, value := range iterable1 {
+		if count(iterable1[:i], value) > 0 {
+			continue
+		}
+
+		if count(iterable1[i:], value) != count(iterable2, value) {
+			return ComparisonError[int, T]{BadItemIndex: i, BadItem: value}
+		}
+	}
+
+	for i, value := range iterable2 {
+		if count(iterable1, value) == 0 {
+			return ComparisonError[int, T]{BadItemIndex: i, BadItem: value}
+		}
+	}
+
+	return nil
+}
+
+// PartitionSlice rearranges container in place, so that all elements satisfying unaryPredicate(element) == true precede all others.
+// The relative order of the elements is not preserved.
+// The index of the first element of the second group is returned.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartitionSlice[T any](container []T, unaryPredicate func(T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := 0
+	for j, value := range container {
+		if unaryPredicate(value) {
+			container[i], container[j] = container[j], container[i]
+			i++
+		}
+	}
+
+	return i, nil
+}
+
+// StablePartitionSlice rearranges container in place, so that all elements satisfying unaryPredicate(element) == true precede all others.
+// The relative order of the elements in both groups is preserved.
+// The index of the first element of the second group is returned.
+// Note that this allocates a buffer for the second group.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func StablePartitionSlice[T any](container []T, unaryPredicate func(T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	rejected := make([]T, 0, len(container))
+
+	i := 0
+	for _, value := range container {
+		if unaryPredicate(value) {
+			container[i] = value
+			i++
+		} else {
+			rejected = append(rejected, value)
+		}
+	}
+
+	copy(container[i:], rejected)
+
+	return i, nil
+}
+
+// PartitionPointSlice finds the index of the first element of the second group in the partitioned container.
+// The search takes O(log(n)) steps, if container is not partitioned by unaryPredicate, the result is unspecified.
+// If all elements satisfy unaryPredicate(element) == true, len(container) is returned.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartitionPointSlice[T any](container []T, unaryPredicate func(T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	return partitionPointIndex(len(container), func(i int) bool { return unaryPredicate(container[i]) }), nil
+}
+
+// IsPartitionedSlice checks if all elements satisfying unaryPredicate(element) == true precede all others.
+// The ComparisonError points at the first element satisfying unaryPredicate after one which did not.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func IsPartitionedSlice[T any](container []T, unaryPredicate func(T) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	i := 0
+	for i < len(container) && unaryPredicate(container[i]) {
+		i++
+	}
+
+	for ; i < len(container); i++ {
+		if unaryPredicate(container[i]) {
+			return ComparisonError[int, T]{BadItemIndex: i, BadItem: container[i]}
+		}
+	}
+
+	return nil
+}
+
+// PartitionCopySlice returns a copy of all elements satisfying unaryPredicate(element) == true and a copy of all others.
+// The relative order of the elements is preserved and original is only traversed once.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartitionCopySlice[T any](original []T, unaryPredicate func(T) bool) ([]T, []T, error) {
+	accepted := make([]T, 0, len(original))
+	rejected := make([]T, 0, len(original))
+
+	if len(original) == 0 {
+		return accepted, rejected, EmptyIterableError{}
+	}
+
+	for _, value := range original {
+		if unaryPredicate(value) {
+			accepted = append(accepted, value)
+		} else {
+			rejected = append(rejected, value)
+		}
+	}
+
+	return accepted, rejected, nil
+}
+
+// PartitionMap returns a copy of all key-value pairs satisfying unaryPredicate(value) == true and a copy of all others.
+// Note that the iteration order of a map is not stable.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartitionMap[TKey comparable, TValue any](original map[TKey]TValue, unaryPredicate func(TValue) bool) (map[TKey]TValue, map[TKey]TValue, error) {
+	accepted := make(map[TKey]TValue)
+	rejected := make(map[TKey]TValue)
+
+	if len(original) == 0 {
+		return accepted, rejected, EmptyIterableError{}
+	}
+
+	for key, value := range original {
+		if unaryPredicate(value) {
+			accepted[key] = value
+		} else {
+			rejected[key] = value
+		}
+	}
+
+	return accepted, rejected, nil
+}
+
+// ReverseSlice reverses the order of the elements in container in place.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ReverseSlice[T any](container []T) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	reverse(container)
+
+	return nil
+}
+
+// RotateSlice rotates the elements in container to the left in place, so that container[n] becomes the first element.
+// n is taken modulo len(container), negative values rotate to the right.
+// The new index of the previously first element is returned.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func RotateSlice[T any](container []T, n int) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	n %= len(container)
+	if n < 0 {
+		n += len(container)
+	}
+
+	reverse(container[:n])
+	reverse(container[n:])
+	reverse(container)
+
+	return (len(container) - n) % len(container), nil
+}
+
+// ShuffleSlice randomly permutes the elements in container in place.
+// The random numbers are drawn from source, so passing a seeded source makes the result reproducible.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ShuffleSlice[T any](container []T, source rand.Source) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	rand.New(source).Shuffle(len(container), func(i, j int) {
+		container[i], container[j] = container[j], container[i]
+	})
+
+	return nil
+}
+
+// SampleSlice returns n randomly selected elements of population, where each element is selected at most once.
+// If n >= len(population), a copy of all elements is returned.
+// The random numbers are drawn from source, so passing a seeded source makes the result reproducible.
+// Note that the order of the sampled elements is not preserved.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SampleSlice[T any](population []T, n int, source rand.Source) ([]T, error) {
+	if len(population) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	n = min(utils.Max(n, 0), len(population))
+	random := rand.New(source)
+
+	reservoir := make([]T, n)
+	copy(reservoir, population)
+
+	for i := n; i < len(population); i++ {
+		j := random.Intn(i + 1)
+		if j < n {
+			reservoir[j] = population[i]
+		}
+	}
+
+	return reservoir, nil
+}
+
+// SampleIterator returns n randomly selected elements of population, where each element is selected at most once.
+// population is consumed once using reservoir sampling, so it's size does not need to be known.
+// If population contains at most n elements, all of them are returned.
+// The random numbers are drawn from source, so passing a seeded source makes the result reproducible.
+// Note that the order of the sampled elements is not preserved.
+// If population fails to yield an element, the elements sampled up to that point are returned alongside the error.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func SampleIterator[TKey any, TValue any](population ds.ReadForIndexIterator[TKey, TValue], n int, source rand.Source) ([]TValue, error) {
+	n = utils.Max(n, 0)
+	reservoir := make([]TValue, 0, n)
+
+	if population.IsEnd() {
+		return reservoir, EmptyIterableError{}
+	}
+
+	random := rand.New(source)
+
+	i := 0
+	for ; population.Next(); i++ {
+		value, found := population.Get()
+		if !found {
+			return reservoir, getError[TValue](population, i)
+		}
+
+		if i < n {
+			reservoir = append(reservoir, value)
+		} else if j := random.Intn(i + 1); j < n {
+			reservoir[j] = value
+		}
+	}
+
+	return reservoir, iteratorError[TValue](population, i)
+}
+
+// UniqueSlicePred removes consecutive equal elements from container in place by moving the kept elements to the front.
+// The new length is returned, the elements after it are left in an unspecified state.
+// The elements are compared with binary_predicate, which should report equality.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func UniqueSlicePred[T any](container []T, binary_predicate func(T, T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := 1
+	for j := 1; j < len(container); j++ {
+		if !binary_predicate(container[i-1], container[j]) {
+			container[i] = container[j]
+			i++
+		}
+	}
+
+	return i, nil
+}
+
+// UniqueCopySlicePred returns a copy of original without consecutive equal elements.
+// The elements are compared with binary_predicate, which should report equality.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func UniqueCopySlicePred[T any](original []T, binary_predicate func(T, T) bool) ([]T, error) {
+	newContainer := make([]T, 0, len(original))
+
+	if len(original) == 0 {
+		return newContainer, EmptyIterableError{}
+	}
+
+	newContainer = append(newContainer, original[0])
+
+	for _, value := range original[1:] {
+		if !binary_predicate(newContainer[len(newContainer)-1], value) {
+			newContainer = append(newContainer, value)
+		}
+	}
+
+	return newContainer, nil
+}
+
+// UniqueIterator returns a lazy iterator skipping elements of original, which are equal to their predecessor.
+// The elements are compared with binary_predicate, which should report equality.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func UniqueIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if original.IsEnd() {
+		return original, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewUnique[TKey, TValue](original, binary_predicate), nil
+}
+
+// RemoveIfSlice removes all elements satisfying unaryPredicate(element) == true from container in place by moving the kept elements to the front.
+// The new length is returned, the elements after it are left in an unspecified state.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func RemoveIfSlice[T any](container []T, unaryPredicate func(T) bool) (int, error) {
+	if len(container) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	i := 0
+	for _, value := range container {
+		if !unaryPredicate(value) {
+			container[i] = value
+			i++
+		}
+	}
+
+	return i, nil
+}
+
+// RemoveIfMap deletes all key-value pairs satisfying unaryPredicate(value) == true from container.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func RemoveIfMap[TKey comparable, TValue any](container map[TKey]TValue, unaryPredicate func(TValue) bool) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for key, value := range container {
+		if unaryPredicate(value) {
+			delete(container, key)
+		}
+	}
+
+	return nil
+}
+
+// RemoveIfString returns a copy of original without all elements satisfying unaryPredicate(element) == true.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func RemoveIfString(original string, unaryPredicate func(rune) bool) (string, error) {
+	var out bytes.Buffer
+
+	if len(original) == 0 {
+		return "", EmptyIterableError{}
+	}
+
+	for _, value := range original {
+		if !unaryPredicate(value) {
+			out.WriteRune(value)
+		}
+	}
+
+	return out.String(), nil
+}
+
+// EraseIfSlice removes all elements satisfying unaryPredicate(element) == true from the slice pointed to by container and truncates it.
+// The relative order of the kept elements is preserved.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func EraseIfSlice[T any](container *[]T, unaryPredicate func(T) bool) error {
+	n, err := RemoveIfSlice(*container, unaryPredicate)
+	if err != nil {
+		return err
+	}
+
+	// Allow removed elements to be garbage collected
+	var zeroVal T
+	for i := n; i < len(*container); i++ {
+		(*container)[i] = zeroVal
+	}
+
+	*container = (*container)[:n]
+
+	return nil
+}
+
+// SearchSlicePred finds the beginning of the first occurrence of sub in super.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func SearchSlicePred[T any](super []T, sub []T, binary_predicate func(T, T) bool) (int, error) {
+	if len(super) == 0 || len(sub) == 0 {
+		return 0, EmptyIterableError{}
+	}
+OUTER:
+	for i := 0; i <= len(super)-len(sub); i++ {
+		for j := 0; j < len(sub); j++ {
+			if !binary_predicate(super[i+j], sub[j]) {
+				continue OUTER
+			}
+		}
+		return i, nil
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+// SearchStringPred finds the beginning of the first occurrence of sub in super.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func SearchStringPred(super string, sub string, binary_predicate func(byte, byte) bool) (int, error) {
+	if len(super) == 0 || len(sub) == 0 {
+		return 0, EmptyIterableError{}
+	}
+OUTER:
+	for i := 0; i <= len(super)-len(sub); i++ {
+		for j := 0; j < len(sub); j++ {
+			if !binary_predicate(super[i+j], sub[j]) {
+				continue OUTER
+			}
+		}
+		return i, nil
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+// SearchNSlicePred finds the beginning of the first run of n consecutive elements in haystack, which are equal to value.
+// If n <= 0, 0 is returned.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func SearchNSlicePred[T any](haystack []T, n int, value T, binary_predicate func(T, T) bool) (int, error) {
+	if len(haystack) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	if n <= 0 {
+		return 0, nil
+	}
+
+	runLength := 0
+	for i, element := range haystack {
+		if !binary_predicate(element, value) {
+			runLength = 0
+
+			continue
+		}
+
+		runLength++
+		if runLength == n {
+			return i - n + 1, nil
+		}
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+// SearchIteratorPred finds the beginning of the first occurrence of sub in super.
+// super is only traversed once, the last len(sub) elements are buffered for comparison.
+// The elements are compared with binary_predicate.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+//   - ExecutionError
+func SearchIteratorPred[TKey any, TValue any](super ds.ReadForIndexIterator[TKey, TValue], sub []TValue, binary_predicate func(TValue, TValue) bool) (int, error) {
+	if super.IsEnd() || len(sub) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	window := make([]TValue, len(sub))
+	nBuffered := 0
+
+OUTER:
+	for super.Next() {
+		value, found := super.Get()
+		if !found {
+			return 0, getError[TValue](super, nBuffered)
+		}
+
+		window[nBuffered%len(sub)] = value
+		nBuffered++
+
+		if nBuffered < len(sub) {
+			continue
+		}
+
+		for j := 0; j < len(sub); j++ {
+			if !binary_predicate(window[(nBuffered+j)%len(sub)], sub[j]) {
+				continue OUTER
+			}
+		}
+
+		i, _ := super.Index()
+
+		return i - len(sub) + 1, nil
+	}
+
+	if err := iteratorError[TValue](super, nBuffered); err != nil {
+		return 0, err
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+// SearchSliceWith finds the beginning of the first occurrence of the needle prepared in searcher in super.
+// Unlike SearchSlicePred, this runs in linear or sublinear time depending on the searcher.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func SearchSliceWith[T any](super []T, searcher Searcher[T]) (int, error) {
+	return searcher.Search(super)
+}
+
+// SearchStringWith finds the beginning of the first occurrence of the needle prepared in searcher in super.
+// Unlike SearchStringPred, this runs in linear or sublinear time depending on the searcher.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func SearchStringWith(super string, searcher StringSearcher) (int, error) {
+	return searcher.Search(super)
+}
+
+// FindEndSliceWith finds the beginning of the last occurrence of the needle prepared in searcher in super.
+// Unlike FindEndSlicePred, this runs in linear or sublinear time depending on the searcher.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func FindEndSliceWith[T any](super []T, searcher Searcher[T]) (int, error) {
+	return searcher.SearchLast(super)
+}
+
+// FindEndStringWith finds the beginning of the last occurrence of the needle prepared in searcher in super.
+// Unlike FindEndStringPred, this runs in linear or sublinear time depending on the searcher.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func FindEndStringWith(super string, searcher StringSearcher) (int, error) {
+	return searcher.SearchLast(super)
+}
+
+// FindFirstOfSliceWith finds the leftmost occurrence of any needle prepared in matcher in haystack.
+// Unlike FindFirstOfSlicePred, the needles are whole subsequences and the search time does not depend on their number.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func FindFirstOfSliceWith[T comparable](haystack []T, matcher *AhoCorasickMatcher[T]) (NeedleMatch, error) {
+	return matcher.FindFirst(haystack)
+}
+
+// FindFirstOfStringWith finds the leftmost occurrence of any needle prepared in matcher in haystack.
+// Unlike FindFirstOfStringPred, the needles are whole substrings and the search time does not depend on their number.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func FindFirstOfStringWith(haystack string, matcher *AhoCorasickStringMatcher) (NeedleMatch, error) {
+	return matcher.FindFirst(haystack)
+}
+
+// LexicographicalCompareSlicePred compares iterable1 and iterable2 lexicographically.
+// -1 is returned if iterable1 is less than iterable2, 1 if it is greater and 0 if they are equivalent.
+// A proper prefix is less than the iterable it is a prefix of, empty iterables are therefore valid inputs.
+// The elements are compared with binary_predicate, which should report if the first argument is less than the second.
+func LexicographicalCompareSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) int {
+	for i := 0; i < min(len(iterable1), len(iterable2)); i++ {
+		if binary_predicate(iterable1[i], iterable2[i]) {
+			return -1
+		}
+
+		if binary_predicate(iterable2[i], iterable1[i]) {
+			return 1
+		}
+	}
+
+	return compareLengths(len(iterable1), len(iterable2))
+}
+
+// LexicographicalCompareSliceCmp compares iterable1 and iterable2 lexicographically.
+// -1 is returned if iterable1 is less than iterable2, 1 if it is greater and 0 if they are equivalent.
+// A proper prefix is less than the iterable it is a prefix of, empty iterables are therefore valid inputs.
+// The elements are compared with the three-way comparator, which should return a negative number, zero or a positive number
+// if the first argument is less than, equivalent to or greater than the second.
+func LexicographicalCompareSliceCmp[T any](iterable1 []T, iterable2 []T, comparator func(T, T) int) int {
+	for i := 0; i < min(len(iterable1), len(iterable2)); i++ {
+		if result := comparator(iterable1[i], iterable2[i]); result < 0 {
+			return -1
+		} else if result > 0 {
+			return 1
+		}
+	}
+
+	return compareLengths(len(iterable1), len(iterable2))
+}
+
+// LexicographicalLessSlicePred returns a predicate reporting if a slice is lexicographically less than another one.
+// The returned predicate can be passed to the sorting algorithms to sort slices of slices.
+// The elements are compared with binary_predicate, which should report if the first argument is less than the second.
+func LexicographicalLessSlicePred[T any](binary_predicate func(T, T) bool) func([]T, []T) bool {
+	return func(iterable1 []T, iterable2 []T) bool {
+		return LexicographicalCompareSlicePred(iterable1, iterable2, binary_predicate) < 0
+	}
+}
+
+// LexicographicalCompareIteratorPred compares iterable1 and iterable2 lexicographically, consuming them up to the first difference.
+// -1 is returned if iterable1 is less than iterable2, 1 if it is greater and 0 if they are equivalent.
+// A proper prefix is less than the iterable it is a prefix of, empty iterables are therefore valid inputs.
+// The elements are compared with binary_predicate, which should report if the first argument is less than the second.
+//
+// Possible Error values:
+//   - ExecutionError
+func LexicographicalCompareIteratorPred[TKey any, TValue any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) (int, error) {
+	for i := 0; ; i++ {
+		hasNext1 := iterable1.Next()
+		hasNext2 := iterable2.Next()
+
+		if !hasNext1 || !hasNext2 {
+			if err := iteratorsError[TValue](iterable1, iterable2, i); err != nil {
+				return 0, err
+			}
+		}
+
+		if !hasNext1 && !hasNext2 {
+			return 0, nil
+		}
+
+		if !hasNext1 {
+			return -1, nil
+		}
+
+		if !hasNext2 {
+			return 1, nil
+		}
+
+		value1, found := iterable1.Get()
+		if !found {
+			return 0, getError[TValue](iterable1, i)
+		}
+
+		value2, found := iterable2.Get()
+		if !found {
+			return 0, getError[TValue](iterable2, i)
+		}
+
+		if binary_predicate(value1, value2) {
+			return -1, nil
+		}
+
+		if binary_predicate(value2, value1) {
+			return 1, nil
+		}
+	}
+}
+
+// EqualSlicePred checks if iterable1 and iterable2 have the same length and binary_predicate(iterable1[i], iterable2[i]) == true for all i.
+// If they differ, the ComparisonError holds the first differing index and the element of iterable1 at it.
+// If iterable1 is a proper prefix of iterable2, the element of iterable2 is used instead.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func EqualSlicePred[T any](iterable1 []T, iterable2 []T, binary_predicate func(T, T) bool) error {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return EmptyIterableError{}
+	}
+
+	i := 0
+	for ; i < min(len(iterable1), len(iterable2)); i++ {
+		if !binary_predicate(iterable1[i], iterable2[i]) {
+			return ComparisonError[int, T]{BadItemIndex: i, BadItem: iterable1[i]}
+		}
+	}
+
+	if i < len(iterable1) {
+		return ComparisonError[int, T]{BadItemIndex: i, BadItem: iterable1[i]}
+	}
+
+	if i < len(iterable2) {
+		return ComparisonError[int, T]{BadItemIndex: i, BadItem: iterable2[i]}
+	}
+
+	return nil
+}
+
+// EqualMapPred checks if iterable1 and iterable2 have the same keys and binary_predicate(iterable1[key], iterable2[key]) == true for all keys.
+// If they differ, the ComparisonError holds a differing key and the value of iterable1 for it.
+// If the key is only contained in iterable2, the value of iterable2 is used instead.
+// Note that the iteration order of a map is not stable.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func EqualMapPred[TKey comparable, TValue any](iterable1 map[TKey]TValue, iterable2 map[TKey]TValue, binary_predicate func(TValue, TValue) bool) error {
+	if len(iterable1) == 0 && len(iterable2) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for key, value1 := range iterable1 {
+		value2, ok := iterable2[key]
+		if !ok || !binary_predicate(value1, value2) {
+			return ComparisonError[TKey, TValue]{BadItemIndex: key, BadItem: value1}
+		}
+	}
+
+	if len(iterable1) != len(iterable2) {
+		for key, value2 := range iterable2 {
+			if _, ok := iterable1[key]; !ok {
+				return ComparisonError[TKey, TValue]{BadItemIndex: key, BadItem: value2}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EqualIteratorPred checks if iterable1 and iterable2 yield the same number of elements
+// and binary_predicate(value1, value2) == true for all pairs of elements, consuming them up to the first difference.
+// If they differ, the ComparisonError holds the first differing index and the element of iterable1 at it.
+// If iterable1 is a proper prefix of iterable2, the element of iterable2 is used instead.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+//   - ExecutionError
+func EqualIteratorPred[TKey any, TValue any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], binary_predicate func(TValue, TValue) bool) error {
+	if iterable1.IsEnd() && iterable2.IsEnd() {
+		return EmptyIterableError{}
+	}
+
+	for i := 0; ; i++ {
+		hasNext1 := iterable1.Next()
+		hasNext2 := iterable2.Next()
+
+		if !hasNext1 || !hasNext2 {
+			if err := iteratorsError[TValue](iterable1, iterable2, i); err != nil {
+				return err
+			}
+		}
+
+		if !hasNext1 && !hasNext2 {
+			return nil
+		}
+
+		if !hasNext1 {
+			value2, found := iterable2.Get()
+			if !found {
+				return getError[TValue](iterable2, i)
+			}
+
+			return ComparisonError[int, TValue]{BadItemIndex: i, BadItem: value2}
+		}
+
+		value1, found := iterable1.Get()
+		if !found {
+			return getError[TValue](iterable1, i)
+		}
+
+		if !hasNext2 {
+			return ComparisonError[int, TValue]{BadItemIndex: i, BadItem: value1}
+		}
+
+		value2, found := iterable2.Get()
+		if !found {
+			return getError[TValue](iterable2, i)
+		}
+
+		if !binary_predicate(value1, value2) {
+			return ComparisonError[int, TValue]{BadItemIndex: i, BadItem: value1}
+		}
+	}
+}
+
+// EqualIterator checks if iterable1 and iterable2 yield the same elements, compared with ==.
+// See EqualIteratorPred for details.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ComparisonError
+func EqualIterator[TKey any, TValue comparable](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue]) error {
+	return EqualIteratorPred(iterable1, iterable2, func(value1 TValue, value2 TValue) bool { return value1 == value2 })
+}
+
+// IotaSlice fills container with value, value+1, value+2, ...
+// For a lazy sequence of increasing values, see generators.NewRange.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func IotaSlice[T constraints.Integer | constraints.Float](container []T, value T) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for i := range container {
+		container[i] = value
+		value++
+	}
+
+	return nil
+}
+
+// InclusiveScanSlice returns the running accumulation of container,
+// where the element at i is container[0] combined with all elements up to and including container[i] through binary_func.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func InclusiveScanSlice[T any](container []T, binary_func func(T, T) T) ([]T, error) {
+	newContainer := make([]T, 0, len(container))
+
+	if len(container) == 0 {
+		return newContainer, EmptyIterableError{}
+	}
+
+	accumulator := container[0]
+	newContainer = append(newContainer, accumulator)
+
+	for _, value := range container[1:] {
+		accumulator = binary_func(accumulator, value)
+		newContainer = append(newContainer, accumulator)
+	}
+
+	return newContainer, nil
+}
+
+// ExclusiveScanSlice returns the running accumulation of container,
+// where the element at i is initialAccumulator combined with all elements before container[i] through binary_func.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ExclusiveScanSlice[T any](container []T, initialAccumulator T, binary_func func(T, T) T) ([]T, error) {
+	newContainer := make([]T, 0, len(container))
+
+	if len(container) == 0 {
+		return newContainer, EmptyIterableError{}
+	}
+
+	for _, value := range container {
+		newContainer = append(newContainer, initialAccumulator)
+		initialAccumulator = binary_func(initialAccumulator, value)
+	}
+
+	return newContainer, nil
+}
+
+// PartialSumSlice returns the running totals of container, the element at i being the sum of container[0] up to and including container[i].
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartialSumSlice[T functional.Addable](container []T) ([]T, error) {
+	return InclusiveScanSlice(container, functional.Add[T])
+}
+
+// InclusiveScanIterator returns a lazy iterator yielding the running accumulation of container,
+// where the element at i is the first element combined with all elements up to and including the i-th one through binary_func.
+// Only the current accumulation is stored.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func InclusiveScanIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKey, TValue], binary_func func(TValue, TValue) TValue) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if container.IsEnd() {
+		return container, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewInclusiveScan[TKey, TValue](container, binary_func), nil
+}
+
+// ExclusiveScanIterator returns a lazy iterator yielding the running accumulation of container,
+// where the element at i is initialAccumulator combined with all elements before the i-th one through binary_func.
+// Only the current accumulation is stored.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ExclusiveScanIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TValue, binary_func func(TValue, TValue) TValue) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if container.IsEnd() {
+		return container, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewExclusiveScan[TKey, TValue](container, initialAccumulator, binary_func), nil
+}
+
+// PartialSumIterator returns a lazy iterator yielding the running totals of container.
+// Only the current total is stored.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PartialSumIterator[TKey any, TValue functional.Addable](container ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	return InclusiveScanIterator(container, functional.Add[TValue])
+}
+
+// AdjacentDifferenceSliceFunc returns container[0] followed by binary_func(container[i], container[i-1]) for all i in [1, len(container)[.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func AdjacentDifferenceSliceFunc[T any](container []T, binary_func func(T, T) T) ([]T, error) {
+	newContainer := make([]T, 0, len(container))
+
+	if len(container) == 0 {
+		return newContainer, EmptyIterableError{}
+	}
+
+	newContainer = append(newContainer, container[0])
+
+	for i := 1; i < len(container); i++ {
+		newContainer = append(newContainer, binary_func(container[i], container[i-1]))
+	}
+
+	return newContainer, nil
+}
+
+// AdjacentDifferenceSlice returns container[0] followed by container[i] - container[i-1] for all i in [1, len(container)[.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func AdjacentDifferenceSlice[T functional.Subtractable](container []T) ([]T, error) {
+	return AdjacentDifferenceSliceFunc(container, functional.Subtract[T])
+}
+
+// AdjacentDifferenceIteratorFunc returns a lazy iterator yielding the first element of container
+// followed by binary_func(current, previous) for all following elements.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func AdjacentDifferenceIteratorFunc[TKey any, TValue any](container ds.ReadForIndexIterator[TKey, TValue], binary_func func(TValue, TValue) TValue) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	if container.IsEnd() {
+		return container, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewAdjacentDifference[TKey, TValue](container, binary_func), nil
+}
+
+// AdjacentDifferenceIterator returns a lazy iterator yielding the first element of container
+// followed by current - previous for all following elements.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func AdjacentDifferenceIterator[TKey any, TValue functional.Subtractable](container ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[TKey, TValue], error) {
+	return AdjacentDifferenceIteratorFunc(container, functional.Subtract[TValue])
+}
+
+// InnerProductSliceFunc returns initialAccumulator after executing
+// initialAccumulator = binary_func_sum(initialAccumulator, binary_func_product(iterable1[i], iterable2[i])) for each i.
+// If the iterables have different lengths, the remaining elements of the longer one are ignored.
+// This is also known as the binary form of transform reduce.
+func InnerProductSliceFunc[T any, TAcc any](iterable1 []T, iterable2 []T, initialAccumulator TAcc, binary_func_sum func(TAcc, TAcc) TAcc, binary_func_product func(T, T) TAcc) TAcc {
+	for i := 0; i < min(len(iterable1), len(iterable2)); i++ {
+		initialAccumulator = binary_func_sum(initialAccumulator, binary_func_product(iterable1[i], iterable2[i]))
+	}
+
+	return initialAccumulator
+}
+
+// InnerProductSlice returns initialAccumulator plus the sum of iterable1[i] * iterable2[i] for each i.
+// If the iterables have different lengths, the remaining elements of the longer one are ignored.
+func InnerProductSlice[T functional.Multiplyable](iterable1 []T, iterable2 []T, initialAccumulator T) T {
+	return InnerProductSliceFunc(iterable1, iterable2, initialAccumulator, functional.Add[T], functional.Multiply[T])
+}
+
+// InnerProductIteratorFunc returns initialAccumulator after executing
+// initialAccumulator = binary_func_sum(initialAccumulator, binary_func_product(value1, value2)) for each pair of elements.
+// If the iterables have different lengths, the remaining elements of the longer one are ignored.
+// If one of the iterables fails to yield an element, the accumulator up to that point is returned alongside the error.
+//
+// Possible Error values:
+//   - ExecutionError
+func InnerProductIteratorFunc[TKey any, TValue any, TAcc any](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TAcc, binary_func_sum func(TAcc, TAcc) TAcc, binary_func_product func(TValue, TValue) TAcc) (TAcc, error) {
+	n := 0
+	for ; iterable1.Next() && iterable2.Next(); n++ {
+		value1, found := iterable1.Get()
+		if !found {
+			return initialAccumulator, getError[TValue](iterable1, n)
+		}
+
+		value2, found := iterable2.Get()
+		if !found {
+			return initialAccumulator, getError[TValue](iterable2, n)
+		}
+
+		initialAccumulator = binary_func_sum(initialAccumulator, binary_func_product(value1, value2))
+	}
+
+	return initialAccumulator, iteratorsError[TValue](iterable1, iterable2, n)
+}
+
+// InnerProductIterator returns initialAccumulator plus the sum of value1 * value2 for each pair of elements.
+// If the iterables have different lengths, the remaining elements of the longer one are ignored.
+//
+// Possible Error values:
+//   - ExecutionError
+func InnerProductIterator[TKey any, TValue functional.Multiplyable](iterable1 ds.ReadForIndexIterator[TKey, TValue], iterable2 ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TValue) (TValue, error) {
+	return InnerProductIteratorFunc(iterable1, iterable2, initialAccumulator, functional.Add[TValue], functional.Multiply[TValue])
+}
+
+// TransformReduceSlice returns initialAccumulator after executing
+// initialAccumulator = binary_func(initialAccumulator, transformer(element)) for each element.
+// For the binary form, combining two iterables, see InnerProductSliceFunc.
+func TransformReduceSlice[T any, TAcc any](container []T, initialAccumulator TAcc, binary_func func(TAcc, TAcc) TAcc, transformer func(T) TAcc) TAcc {
+	for _, value := range container {
+		initialAccumulator = binary_func(initialAccumulator, transformer(value))
+	}
+
+	return initialAccumulator
+}
+
+// TransformReduceIterator returns initialAccumulator after executing
+// initialAccumulator = binary_func(initialAccumulator, transformer(element)) for each element.
+// For the binary form, combining two iterables, see InnerProductIteratorFunc.
+// If container fails to yield an element, the accumulator up to that point is returned alongside the error.
+//
+// Possible Error values:
+//   - ExecutionError
+func TransformReduceIterator[TKey any, TValue any, TAcc any](container ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TAcc, binary_func func(TAcc, TAcc) TAcc, transformer func(TValue) TAcc) (TAcc, error) {
+	n := 0
+	for ; container.Next(); n++ {
+		value, found := container.Get()
+		if !found {
+			return initialAccumulator, getError[TValue](container, n)
+		}
+
+		initialAccumulator = binary_func(initialAccumulator, transformer(value))
+	}
+
+	return initialAccumulator, iteratorError[TValue](container, n)
+}
+
+// FoldSlice returns initialAccumulator after executing initialAccumulator = binary_func(initialAccumulator, element) for each element.
+// Unlike AccumulateSlice, the accumulator can have a different type than the elements.
+// Errors returned by binary_func are propagated to the caller of FoldSlice alongside the accumulator before the failing element.
+//
+// Possible Error values:
+//   - ExecutionError
+func FoldSlice[T any, TAcc any](container []T, initialAccumulator TAcc, binary_func func(TAcc, T) (TAcc, error)) (TAcc, error) {
+	for i, value := range container {
+		accumulator, err := binary_func(initialAccumulator, value)
+		if err != nil {
+			return initialAccumulator, ExecutionError[int, T]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+
+		initialAccumulator = accumulator
+	}
+
+	return initialAccumulator, nil
+}
+
+// FoldMap returns initialAccumulator after executing initialAccumulator = binary_func(initialAccumulator, element) for each element.
+// Unlike AccumulateMap, the accumulator can have a different type than the elements.
+// Errors returned by binary_func are propagated to the caller of FoldMap alongside the accumulator before the failing element.
+// Note that the iteration order of a map is not stable.
+//
+// Possible Error values:
+//   - ExecutionError
+func FoldMap[TKey comparable, TValue any, TAcc any](container map[TKey]TValue, initialAccumulator TAcc, binary_func func(TAcc, TValue) (TAcc, error)) (TAcc, error) {
+	for key, value := range container {
+		accumulator, err := binary_func(initialAccumulator, value)
+		if err != nil {
+			return initialAccumulator, ExecutionError[TKey, TValue]{BadItemIndex: key, BadItem: value, Inner: err}
+		}
+
+		initialAccumulator = accumulator
+	}
+
+	return initialAccumulator, nil
+}
+
+// FoldString returns initialAccumulator after executing initialAccumulator = binary_func(initialAccumulator, element) for each element.
+// Unlike AccumulateString, the accumulator can have a different type than the elements.
+// Errors returned by binary_func are propagated to the caller of FoldString alongside the accumulator before the failing element.
+//
+// Possible Error values:
+//   - ExecutionError
+func FoldString[TAcc any](container string, initialAccumulator TAcc, binary_func func(TAcc, rune) (TAcc, error)) (TAcc, error) {
+	for i, value := range container {
+		accumulator, err := binary_func(initialAccumulator, value)
+		if err != nil {
+			return initialAccumulator, ExecutionError[int, rune]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+
+		initialAccumulator = accumulator
+	}
+
+	return initialAccumulator, nil
+}
+
+// FoldIterator returns initialAccumulator after executing initialAccumulator = binary_func(initialAccumulator, element) for each element.
+// Unlike AccumulateIterator, the accumulator can have a different type than the elements.
+// Errors returned by binary_func are propagated to the caller of FoldIterator alongside the accumulator before the failing element.
+// If container implements compounditerators.ErrIterator, an error stopping the iteration is propagated as well.
+//
+// Possible Error values:
+//   - ExecutionError
+func FoldIterator[TKey any, TValue any, TAcc any](container ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TAcc, binary_func func(TAcc, TValue) (TAcc, error)) (TAcc, error) {
+	return foldIterator(container, initialAccumulator, binary_func, 0)
+}
+
+// foldIterator implements FoldIterator for a container, of which n elements have already been consumed.
+func foldIterator[TKey any, TValue any, TAcc any](container ds.ReadForIndexIterator[TKey, TValue], initialAccumulator TAcc, binary_func func(TAcc, TValue) (TAcc, error), n int) (TAcc, error) {
+	for ; container.Next(); n++ {
+		value, found := container.Get()
+		if !found {
+			return initialAccumulator, getError[TValue](container, n)
+		}
+
+		accumulator, err := binary_func(initialAccumulator, value)
+		if err != nil {
+			i, _ := container.Index()
+			return initialAccumulator, ExecutionError[int, TValue]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+
+		initialAccumulator = accumulator
+	}
+
+	return initialAccumulator, iteratorError[TValue](container, n)
+}
+
+// FoldRightSlice works like FoldSlice, but processes the elements from the last to the first one.
+//
+// Possible Error values:
+//   - ExecutionError
+func FoldRightSlice[T any, TAcc any](container []T, initialAccumulator TAcc, binary_func func(TAcc, T) (TAcc, error)) (TAcc, error) {
+	for i := len(container) - 1; i >= 0; i-- {
+		accumulator, err := binary_func(initialAccumulator, container[i])
+		if err != nil {
+			return initialAccumulator, ExecutionError[int, T]{BadItemIndex: i, BadItem: container[i], Inner: err}
+		}
+
+		initialAccumulator = accumulator
+	}
+
+	return initialAccumulator, nil
+}
+
+// FoldRightString works like FoldString, but processes the runes from the last to the first one.
+// The index in the ExecutionError is the byte index of the failing rune.
+//
+// Possible Error values:
+//   - ExecutionError
+func FoldRightString[TAcc any](container string, initialAccumulator TAcc, binary_func func(TAcc, rune) (TAcc, error)) (TAcc, error) {
+	for i := len(container); i > 0; {
+		value, size := utf8.DecodeLastRuneInString(container[:i])
+		i -= size
+
+		accumulator, err := binary_func(initialAccumulator, value)
+		if err != nil {
+			return initialAccumulator, ExecutionError[int, rune]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+
+		initialAccumulator = accumulator
+	}
+
+	return initialAccumulator, nil
+}
+
+// ReduceSlice works like FoldSlice, but uses the first element as the initial accumulator.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func ReduceSlice[T any](container []T, binary_func func(T, T) (T, error)) (T, error) {
+	if len(container) == 0 {
+		var zero T
+
+		return zero, EmptyIterableError{}
+	}
+
+	accumulator, err := FoldSlice(container[1:], container[0], binary_func)
+	if executionError, ok := err.(ExecutionError[int, T]); ok {
+		executionError.BadItemIndex++
+
+		return accumulator, executionError
+	}
+
+	return accumulator, err
+}
+
+// ReduceMap works like FoldMap, but uses an arbitrary element as the initial accumulator.
+// Note that the iteration order of a map is not stable.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func ReduceMap[TKey comparable, TValue any](container map[TKey]TValue, binary_func func(TValue, TValue) (TValue, error)) (TValue, error) {
+	var accumulator TValue
+
+	if len(container) == 0 {
+		return accumulator, EmptyIterableError{}
+	}
+
+	isFirst := true
+	for key, value := range container {
+		if isFirst {
+			accumulator = value
+			isFirst = false
+
+			continue
+		}
+
+		newAccumulator, err := binary_func(accumulator, value)
+		if err != nil {
+			return accumulator, ExecutionError[TKey, TValue]{BadItemIndex: key, BadItem: value, Inner: err}
+		}
+
+		accumulator = newAccumulator
+	}
+
+	return accumulator, nil
+}
+
+// ReduceIterator works like FoldIterator, but uses the first element as the initial accumulator.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func ReduceIterator[TKey any, TValue any](container ds.ReadForIndexIterator[TKey, TValue], binary_func func(TValue, TValue) (TValue, error)) (TValue, error) {
+	var accumulator TValue
+
+	if container.IsEnd() || !container.Next() {
+		return accumulator, EmptyIterableError{}
+	}
+
+	accumulator, found := container.Get()
+	if !found {
+		return accumulator, getError[TValue](container, 0)
+	}
+
+	return foldIterator(container, accumulator, binary_func, 1)
+}
+
+// ZipIterator returns a lazy iterator yielding pairs of the elements of iterable1 and iterable2 in lockstep.
+// The iterator ends as soon as one of the iterables ends.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ZipIterator[TKey1 any, TValue1 any, TKey2 any, TValue2 any](iterable1 ds.ReadForIndexIterator[TKey1, TValue1], iterable2 ds.ReadForIndexIterator[TKey2, TValue2]) (ds.ReadForIndexIterator[int, tuple.T2[TValue1, TValue2]], error) {
+	zipped := iteratoradapters.NewZip(iterable1, iterable2)
+
+	if iterable1.IsEnd() || iterable2.IsEnd() {
+		return zipped, EmptyIterableError{}
+	}
+
+	return zipped, nil
+}
+
+// ZipLongestIterator returns a lazy iterator yielding pairs of the elements of iterable1 and iterable2 in lockstep.
+// The iterator ends after both iterables ended, missing elements of the shorter one are replaced with fill1 or fill2 respectively.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ZipLongestIterator[TKey1 any, TValue1 any, TKey2 any, TValue2 any](iterable1 ds.ReadForIndexIterator[TKey1, TValue1], iterable2 ds.ReadForIndexIterator[TKey2, TValue2], fill1 TValue1, fill2 TValue2) (ds.ReadForIndexIterator[int, tuple.T2[TValue1, TValue2]], error) {
+	zipped := iteratoradapters.NewZipLongest(iterable1, iterable2, fill1, fill2)
+
+	if iterable1.IsEnd() && iterable2.IsEnd() {
+		return zipped, EmptyIterableError{}
+	}
+
+	return zipped, nil
+}
+
+// ZipNIterator returns a lazy iterator yielding slices of the elements of all originals in lockstep.
+// The iterator ends as soon as one of the originals ends.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ZipNIterator[TKey any, TValue any](originals ...ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[int, []TValue], error) {
+	zipped := iteratoradapters.NewZipN(originals...)
+
+	if len(originals) == 0 {
+		return zipped, EmptyIterableError{}
+	}
+
+	for _, original := range originals {
+		if original.IsEnd() {
+			return zipped, EmptyIterableError{}
+		}
+	}
+
+	return zipped, nil
+}
+
+// ZipNLongestIterator returns a lazy iterator yielding slices of the elements of all originals in lockstep.
+// The iterator ends after all originals ended, missing elements of the shorter ones are replaced with fill.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ZipNLongestIterator[TKey any, TValue any](fill TValue, originals ...ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[int, []TValue], error) {
+	zipped := iteratoradapters.NewZipNLongest(fill, originals...)
+
+	for _, original := range originals {
+		if !original.IsEnd() {
+			return zipped, nil
+		}
+	}
+
+	return zipped, EmptyIterableError{}
+}
+
+// UnzipIterator splits original into two lazy iterators yielding the first and second components of its pairs respectively.
+// The iterators can be advanced independently, elements not yet consumed by the other one are buffered.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func UnzipIterator[TKey any, TValue1 any, TValue2 any](original ds.ReadForIndexIterator[TKey, tuple.T2[TValue1, TValue2]]) (ds.ReadForIndexIterator[int, TValue1], ds.ReadForIndexIterator[int, TValue2], error) {
+	first, second := iteratoradapters.NewUnzip(original)
+
+	if original.IsEnd() {
+		return first, second, EmptyIterableError{}
+	}
+
+	return first, second, nil
+}
+
+// EnumerateIterator returns a lazy iterator yielding the elements of original paired with their position, starting at 0.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func EnumerateIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[TKey, tuple.T2[int, TValue]], error) {
+	enumerated := iteratoradapters.NewEnumerate(original)
+
+	if original.IsEnd() {
+		return enumerated, EmptyIterableError{}
+	}
+
+	return enumerated, nil
+}
+
+// ForeachSliceIndexed executes binary_func(i, container[i]) for each i in [0, len(container)[.
+// Errors returned by binary_func are propagated to the caller of ForeachSliceIndexed.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func ForeachSliceIndexed[T any](container []T, binary_func func(int, T) error) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for i, value := range container {
+		err := binary_func(i, value)
+		if err != nil {
+			return ExecutionError[int, T]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+	}
+
+	return nil
+}
+
+// ForeachMapIndexed executes ternary_func(i, key, container[key]) for each key in container.
+// Unlike ForeachMap, the keys are visited in ascending order, i being the position of key in that order.
+// Errors returned by ternary_func are propagated to the caller of ForeachMapIndexed.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func ForeachMapIndexed[TKey constraints.Ordered, TValue any](container map[TKey]TValue, ternary_func func(int, TKey, TValue) error) error {
+	if len(container) == 0 {
+		return EmptyIterableError{}
+	}
+
+	for i, key := range sortedKeys(container) {
+		err := ternary_func(i, key, container[key])
+		if err != nil {
+			return ExecutionError[TKey, TValue]{BadItemIndex: key, BadItem: container[key], Inner: err}
+		}
+	}
+
+	return nil
+}
+
+// TransformCopySliceIndexed applies transformer(i, container[i]) for all i in [0, len(container)[ and returns the newly created container.
+// Note that the transformer can return a different type than it's input.
+// Errors returned by transformer are propagated to the caller of TransformCopySliceIndexed.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func TransformCopySliceIndexed[T any, TOut any](container []T, transformer func(int, T) (TOut, error)) ([]TOut, error) {
+	res := make([]TOut, 0, len(container))
+
+	if len(container) == 0 {
+		return res, EmptyIterableError{}
+	}
+
+	for i, value := range container {
+		newVal, err := transformer(i, value)
+		if err != nil {
+			return res, ExecutionError[int, T]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+
+		res = append(res, newVal)
+	}
+
+	return res, nil
+}
+
+// ZipWithIndexMap returns the entries of container as (i, key, value) triples, sorted by ascending key.
+// Unlike iterating the map directly, the order and therefore the positions are stable.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ZipWithIndexMap[TKey constraints.Ordered, TValue any](container map[TKey]TValue) ([]tuple.T3[int, TKey, TValue], error) {
+	res := make([]tuple.T3[int, TKey, TValue], 0, len(container))
+
+	if len(container) == 0 {
+		return res, EmptyIterableError{}
+	}
+
+	for i, key := range sortedKeys(container) {
+		res = append(res, tuple.New3(i, key, container[key]))
+	}
+
+	return res, nil
+}
+
+// ChunkSlice splits container into consecutive, non-overlapping sub-slices of n elements without copying.
+// The last chunk is shorter if len(container) is not divisible by n, a non-positive n results in no chunks.
+// The capacity of each chunk is limited to its length, so appending to a chunk does not overwrite the following ones.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ChunkSlice[T any](container []T, n int) ([][]T, error) {
+	if len(container) == 0 {
+		return [][]T{}, EmptyIterableError{}
+	}
+
+	if n <= 0 {
+		return [][]T{}, nil
+	}
+
+	chunks := make([][]T, 0, (len(container)+n-1)/n)
+	for i := 0; i < len(container); i += n {
+		end := min(i+n, len(container))
+		chunks = append(chunks, container[i:end:end])
+	}
+
+	return chunks, nil
+}
+
+// WindowSlice returns sub-slices of size consecutive elements of container without copying, the start of each window advancing by step elements.
+// Trailing elements, which do not fill a whole window, are not included, a non-positive size or step results in no windows.
+// The capacity of each window is limited to its length, so appending to a window does not overwrite the following elements.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func WindowSlice[T any](container []T, size int, step int) ([][]T, error) {
+	if len(container) == 0 {
+		return [][]T{}, EmptyIterableError{}
+	}
+
+	windows := [][]T{}
+	if size <= 0 || step <= 0 {
+		return windows, nil
+	}
+
+	for i := 0; i+size <= len(container); i += step {
+		windows = append(windows, container[i:i+size:i+size])
+	}
+
+	return windows, nil
+}
+
+// ChunkIterator returns a lazy iterator yielding consecutive, non-overlapping slices of n elements of original.
+// The last chunk is shorter if the number of elements is not divisible by n, a non-positive n results in no chunks.
+// If reuseBuffer is set, all chunks share one buffer, which is only valid until the next call to Next, avoiding an allocation per chunk.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func ChunkIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], n int, reuseBuffer bool) (ds.ReadForIndexIterator[int, []TValue], error) {
+	chunks := iteratoradapters.NewChunk(original, n, reuseBuffer)
+
+	if original.IsEnd() {
+		return chunks, EmptyIterableError{}
+	}
+
+	return chunks, nil
+}
+
+// WindowIterator returns a lazy iterator yielding slices of size consecutive elements of original, the start of each window advancing by step elements.
+// Trailing elements, which do not fill a whole window, are not yielded, a non-positive size or step results in no windows.
+// If reuseBuffer is set, all windows share one buffer, which is only valid until the next call to Next, avoiding an allocation per window.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func WindowIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], size int, step int, reuseBuffer bool) (ds.ReadForIndexIterator[int, []TValue], error) {
+	windows := iteratoradapters.NewWindow(original, size, step, reuseBuffer)
+
+	if original.IsEnd() {
+		return windows, EmptyIterableError{}
+	}
+
+	return windows, nil
+}
+
+// PairwiseIterator returns a lazy iterator yielding each pair of adjacent elements of original.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func PairwiseIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue]) (ds.ReadForIndexIterator[int, tuple.T2[TValue, TValue]], error) {
+	pairs := iteratoradapters.NewPairwise(original)
+
+	if original.IsEnd() {
+		return pairs, EmptyIterableError{}
+	}
+
+	return pairs, nil
+}
+
+// SplitNSlice splits container into m sub-slices of roughly equal length without copying, the first len(container) % m ones containing one more element.
+// If m is greater than len(container), the trailing parts are empty, a non-positive m results in no parts.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SplitNSlice[T any](container []T, m int) ([][]T, error) {
+	if len(container) == 0 {
+		return [][]T{}, EmptyIterableError{}
+	}
+
+	if m <= 0 {
+		return [][]T{}, nil
+	}
+
+	parts := make([][]T, 0, m)
+
+	start := 0
+	for k := 0; k < m; k++ {
+		end := start + len(container)/m
+		if k < len(container)%m {
+			end++
+		}
+
+		parts = append(parts, container[start:end:end])
+		start = end
+	}
+
+	return parts, nil
+}
+
+// SplitNIterator splits original into m lazy parts of roughly equal size, the first len % m ones containing one more element.
+// The parts share original, consuming them in order, e.g. with JoinIterator, reads every element exactly once without buffering.
+// Elements of earlier parts, which are skipped by advancing a later part first, are buffered.
+// If original is not a random access iterator, its remaining elements are buffered up front to count them.
+// A non-positive m results in no parts.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SplitNIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], m int) ([]ds.ReadForIndexIterator[TKey, TValue], error) {
+	if original.IsEnd() {
+		return []ds.ReadForIndexIterator[TKey, TValue]{}, EmptyIterableError{}
+	}
+
+	return iteratoradapters.NewSplitN(original, m), nil
+}
+
+// SplitWhenIterator returns a lazy iterator yielding lazy parts of original, separated by the elements satisfying unaryPredicate(element) == true.
+// The separators are not part of any part, so n separators result in n+1, possibly empty, parts.
+// Advancing to the next part skips the remaining elements of the current one.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func SplitWhenIterator[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool) (ds.ReadForIndexIterator[int, ds.ReadForIndexIterator[TKey, TValue]], error) {
+	parts := iteratoradapters.NewSplitWhen(original, unaryPredicate)
+
+	if original.IsEnd() {
+		return parts, EmptyIterableError{}
+	}
+
+	return parts, nil
+}
+
+// FlattenSlice concatenates the slices in container into a newly created slice.
+// Exactly one level is flattened, because Go's type parameters cannot express an arbitrary nesting depth.
+// Typed slices nested deeper, e.g. [][][]T, are flattened one level per call, so depth levels take depth calls.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func FlattenSlice[T any](container [][]T) ([]T, error) {
+	if len(container) == 0 {
+		return []T{}, EmptyIterableError{}
+	}
+
+	n := 0
+	for _, inner := range container {
+		n += len(inner)
+	}
+
+	res := make([]T, 0, n)
+	for _, inner := range container {
+		res = append(res, inner...)
+	}
+
+	return res, nil
+}
+
+// FlattenSliceDepth flattens nested slices in container up to depth levels deep into a newly created slice.
+// Elements of any slice type are flattened, so both []any{1, []any{2}} and [][][]int{{{1}}} are accepted.
+// A depth of 0 returns a copy of container, a negative depth flattens all levels.
+// Since the element type of the result depends on depth, the elements are returned as any.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func FlattenSliceDepth[T any](container []T, depth int) ([]any, error) {
+	res := make([]any, 0, len(container))
+
+	if len(container) == 0 {
+		return res, EmptyIterableError{}
+	}
+
+	return flattenSliceDepth(res, reflect.ValueOf(container), depth), nil
+}
+
+func flattenSliceDepth(res []any, container reflect.Value, depth int) []any {
+	for i := 0; i < container.Len(); i++ {
+		value := container.Index(i).Interface()
+
+		if inner := reflect.ValueOf(value); inner.Kind() == reflect.Slice && depth != 0 {
+			res = flattenSliceDepth(res, inner, depth-1)
+		} else {
+			res = append(res, value)
+		}
+	}
+
+	return res
+}
+
+// FlatMapSlice concatenates the slices returned by transformer(element) for each element of container into a newly created slice.
+// Errors returned by transformer are propagated to the caller of FlatMapSlice.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ExecutionError
+func FlatMapSlice[T any, TOut any](container []T, transformer func(T) ([]TOut, error)) ([]TOut, error) {
+	res := make([]TOut, 0, len(container))
+
+	if len(container) == 0 {
+		return res, EmptyIterableError{}
+	}
+
+	for i, value := range container {
+		values, err := transformer(value)
+		if err != nil {
+			return res, ExecutionError[int, T]{BadItemIndex: i, BadItem: value, Inner: err}
+		}
+
+		res = append(res, values...)
+	}
+
+	return res, nil
+}
+
+// FlattenIterator returns a lazy iterator yielding the elements of the iterators yielded by original in sequence.
+// Unlike JoinIterator, the number of iterators does not need to be known up front.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func FlattenIterator[TKey any, TInnerKey any, TValue any](original ds.ReadForIndexIterator[TKey, ds.ReadForIndexIterator[TInnerKey, TValue]]) (ds.ReadForIndexIterator[TInnerKey, TValue], error) {
+	flattened := iteratoradapters.NewFlatten(original)
+
+	if original.IsEnd() {
+		return flattened, EmptyIterableError{}
+	}
+
+	return flattened, nil
+}
+
+// FlatMapIterator returns a lazy iterator yielding the elements of the slices returned by transformer(element) for each element of original in sequence.
+// If transformer returns an error, the iteration stops.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func FlatMapIterator[TKey any, TValue any, TOut any](original ds.ReadForIndexIterator[TKey, TValue], transformer func(TValue) ([]TOut, error)) (ds.ReadForIndexIterator[int, TOut], error) {
+	flattened := iteratoradapters.NewFlatMap(original, transformer)
+
+	if original.IsEnd() {
+		return flattened, EmptyIterableError{}
+	}
+
+	return flattened, nil
+}
+
+// FlatMapIteratorLazy returns a lazy iterator yielding the elements of the iterators returned by transformer(element) for each element of original in sequence.
+// If transformer returns an error, the iteration stops.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func FlatMapIteratorLazy[TKey any, TValue any, TInnerKey any, TOut any](original ds.ReadForIndexIterator[TKey, TValue], transformer func(TValue) (ds.ReadForIndexIterator[TInnerKey, TOut], error)) (ds.ReadForIndexIterator[TInnerKey, TOut], error) {
+	flattened := iteratoradapters.NewFlatMapIterator(original, transformer)
+
+	if original.IsEnd() {
+		return flattened, EmptyIterableError{}
+	}
+
+	return flattened, nil
+}
+
+// MapIterator returns a lazy iterator yielding transformer(element) for each element of original.
+// Unlike TransformIterator, the transformer can return a different type than it's input.
+// If transformer returns an error, the iteration stops.
+// The error can be retrieved through the Err() error method of the returned iterator.
+//
+// Possible Error values:
+//   - EmptyIterableError
+func MapIterator[TKey any, TIn any, TOut any](original ds.ReadForIndexIterator[TKey, TIn], transformer func(TIn) (TOut, error)) (ds.ReadForIndexIterator[TKey, TOut], error) {
+	mapped := iteratoradapters.NewMap(original, transformer)
+
+	if original.IsEnd() {
+		return mapped, EmptyIterableError{}
+	}
+
+	return mapped, nil
 }
@@ -1,12 +1,19 @@
 package goaoi_test
 
 import (
+	"math/rand"
+	"strconv"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/JonasMuehlmann/datastructures.go/ds"
 	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
 	"github.com/JonasMuehlmann/goaoi"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 	"github.com/JonasMuehlmann/goaoi/functional"
+	iteratoradapters "github.com/JonasMuehlmann/goaoi/iterator_adapters"
+	"github.com/barweiss/go-tuple"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -582,6 +589,26 @@ func Test_TakeWhileIterator(t *testing.T) {
 	}
 }
 
+func Test_TakeWhileIteratorIndex(t *testing.T) {
+	it, err := goaoi.TakeWhileIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin(), func(x int) bool { return x < 3 })
+	assert.Nil(t, err)
+	assert.True(t, it.IsBegin())
+
+	for i := 0; i < 2; i++ {
+		assert.True(t, it.Next())
+
+		index, valid := it.Index()
+		assert.Equal(t, i, index)
+		assert.True(t, valid)
+		assert.Equal(t, i == 0, it.IsFirst())
+	}
+
+	assert.False(t, it.Next())
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.Next())
+	assert.Equal(t, 2, it.Size())
+}
+
 func Test_TakeNIterator(t *testing.T) {
 	tcs := []struct {
 		original []int
@@ -880,6 +907,7 @@ func Test_ReplaceIfSlice(t *testing.T) {
 	}{
 		{[]int{1, 2}, func(x int) bool { return x == 1 }, 0, []int{0, 2}, nil, "Found"},
 		{[]int{1, 2}, func(x int) bool { return x == -1 }, 0, []int{1, 2}, nil, "Not Found"},
+		{[]int{1, 2, 1}, func(x int) bool { return x == 1 }, 7, []int{7, 2, 7}, nil, "Non-zero replacement"},
 		{[]int{}, func(x int) bool { return x == -1 }, 0, []int(nil), goaoi.EmptyIterableError{}, "Empty"},
 	}
 	for _, tc := range tcs {
@@ -939,6 +967,7 @@ func Test_ReplaceIfIterator(t *testing.T) {
 	}{
 		{[]int{1, 2}, func(x int) bool { return x == 1 }, 0, []int{0, 2}, nil, "Found"},
 		{[]int{1, 2}, func(x int) bool { return x == -1 }, 0, []int{1, 2}, nil, "Not Found"},
+		{[]int{1, 2, 1}, func(x int) bool { return x == 1 }, 7, []int{7, 2, 7}, nil, "Non-zero replacement"},
 		{[]int{}, func(x int) bool { return x == -1 }, 0, []int{}, goaoi.EmptyIterableError{}, "Empty"},
 	}
 	for _, tc := range tcs {
@@ -1018,6 +1047,38 @@ func Test_TransformIterator(t *testing.T) {
 	}
 }
 
+func Test_TransformIteratorError(t *testing.T) {
+	transformer := func(i int) (int, error) {
+		if i == 2 {
+			return 0, assert.AnError
+		}
+
+		return i, nil
+	}
+
+	it, _ := goaoi.TransformIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3}).Begin(), transformer)
+
+	assert.True(t, it.Next())
+	value, found := it.Get()
+	assert.Equal(t, 1, value)
+	assert.True(t, found)
+	assert.Nil(t, compounditerators.ErrOf(it))
+
+	assert.True(t, it.Next())
+	_, found = it.Get()
+	assert.False(t, found)
+	assert.Equal(t, assert.AnError, compounditerators.ErrOf(it))
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.Next())
+
+	it, _ = goaoi.TransformIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3}).Begin(), transformer)
+	res := []int{}
+	err := goaoi.ForeachIterator(it, func(i int) error { res = append(res, i); return nil })
+
+	assert.Equal(t, []int{1}, res)
+	assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 1, Inner: assert.AnError}, err)
+}
+
 func Test_TransformCopySlice(t *testing.T) {
 	tcs := []struct {
 		original    []int
@@ -1446,3 +1507,3222 @@ func Test_MinMaxMapPred(t *testing.T) {
 		})
 	}
 }
+
+func Test_SortSlicePred(t *testing.T) {
+	tcs := []struct {
+		original   []int
+		comparator func(int, int) bool
+		exp        []int
+		err        error
+		name       string
+	}{
+		{[]int{3, 1, 2}, functional.IsLessThan[int], []int{1, 2, 3}, nil, "Ascending"},
+		{[]int{3, 1, 2}, functional.IsGreaterThan[int], []int{3, 2, 1}, nil, "Descending"},
+		{[]int{1, 2, 3}, functional.IsLessThan[int], []int{1, 2, 3}, nil, "Already sorted"},
+		{[]int{}, functional.IsLessThan[int], []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.SortSlicePred(tc.original, tc.comparator)
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_StableSortSlicePred(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+
+	tcs := []struct {
+		original []pair
+		exp      []pair
+		err      error
+		name     string
+	}{
+		{[]pair{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}}, []pair{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}, nil, "Equal keys keep order"},
+		{[]pair{}, []pair{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.StableSortSlicePred(tc.original, func(a pair, b pair) bool { return a.key < b.key })
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_PartialSortSlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		n        int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{5, 3, 9, 1, 7, 2}, 3, []int{1, 2, 3}, nil, "Top 3"},
+		{[]int{5, 3, 9, 1, 7, 2}, 0, []int{}, nil, "Top 0"},
+		{[]int{5, 3, 9, 1}, 10, []int{1, 3, 5, 9}, nil, "More than exists"},
+		{[]int{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, 4, []int{0, 1, 2, 3}, nil, "Large input"},
+		{[]int{}, 1, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.PartialSortSlicePred(tc.original, tc.n, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, tc.original[:len(tc.exp)])
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_PartialSortCopySlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		n        int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{5, 3, 9, 1, 7, 2}, 3, []int{1, 2, 3}, nil, "Top 3"},
+		{[]int{5, 3, 9, 1}, 10, []int{1, 3, 5, 9}, nil, "More than exists"},
+		{[]int{5, 3, 9, 1}, -1, []int{}, nil, "Negative n"},
+		{[]int{}, 1, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			originalBackup := append([]int{}, tc.original...)
+			res, err := goaoi.PartialSortCopySlicePred(tc.original, tc.n, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, originalBackup, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_NthElementSlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		n        int
+		exp      int
+		err      error
+		name     string
+	}{
+		{[]int{5, 3, 9, 1, 7, 2}, 2, 3, nil, "Middle"},
+		{[]int{5, 3, 9, 1, 7, 2}, 0, 1, nil, "First"},
+		{[]int{5, 3, 9, 1, 7, 2}, 5, 9, nil, "Last"},
+		{[]int{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 1, 9}, 10, 4, nil, "Many duplicates"},
+		{[]int{20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, 7, 7, nil, "Large input"},
+		{[]int{}, 0, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.NthElementSlicePred(tc.original, tc.n, functional.IsLessThan[int])
+
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.exp, tc.original[tc.n])
+
+				for i := 0; i < tc.n; i++ {
+					assert.LessOrEqual(t, tc.original[i], tc.exp)
+				}
+				for i := tc.n + 1; i < len(tc.original); i++ {
+					assert.GreaterOrEqual(t, tc.original[i], tc.exp)
+				}
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_IsSortedUntilSlicePred(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 2, 3}, 4, nil, "Sorted"},
+		{[]int{1, 2, 0, 3}, 2, goaoi.ComparisonError[int, int]{BadItemIndex: 2, BadItem: 0}, "Unsorted"},
+		{[]int{1}, 1, nil, "Single element"},
+		{[]int{}, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.IsSortedUntilSlicePred(tc.container, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, goaoi.IsSortedSlicePred(tc.container, functional.IsLessThan[int]))
+			} else {
+				assert.Equal(t, tc.err, err)
+				assert.Equal(t, tc.err, goaoi.IsSortedSlicePred(tc.container, functional.IsLessThan[int]))
+			}
+
+		})
+	}
+}
+
+func Test_LowerBoundSlicePred(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		needle   int
+		exp      int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 2, 2, 3}, 2, 1, nil, "Found first of equal"},
+		{[]int{1, 3, 5}, 4, 2, nil, "Between elements"},
+		{[]int{1, 3, 5}, 0, 0, nil, "Before first"},
+		{[]int{1, 3, 5}, 6, 3, goaoi.ElementNotFoundError{}, "After last"},
+		{[]int{}, 1, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.LowerBoundSlicePred(tc.haystack, tc.needle, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_UpperBoundSlicePred(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		needle   int
+		exp      int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 2, 2, 3}, 2, 4, nil, "Found after equal"},
+		{[]int{1, 3, 5}, 4, 2, nil, "Between elements"},
+		{[]int{1, 3, 5}, 0, 0, nil, "Before first"},
+		{[]int{1, 3, 5}, 5, 3, goaoi.ElementNotFoundError{}, "Equal to last"},
+		{[]int{}, 1, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.UpperBoundSlicePred(tc.haystack, tc.needle, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_EqualRangeSlicePred(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		needle   int
+		expFirst int
+		expLast  int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 2, 2, 3}, 2, 1, 4, nil, "Multiple equal"},
+		{[]int{1, 2, 3}, 3, 2, 3, nil, "Single at end"},
+		{[]int{1, 3, 5}, 4, 2, 2, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{}, 1, 0, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			first, last, err := goaoi.EqualRangeSlicePred(tc.haystack, tc.needle, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.expFirst, first)
+			assert.Equal(t, tc.expLast, last)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_BinarySearchSlicePred(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		needle   int
+		exp      int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 2, 2, 3}, 2, 1, nil, "Found"},
+		{[]int{1, 2, 3}, 1, 0, nil, "Found at beginning"},
+		{[]int{1, 3, 5}, 4, 0, goaoi.ElementNotFoundError{}, "Not found between"},
+		{[]int{1, 3, 5}, 6, 0, goaoi.ElementNotFoundError{}, "Not found after"},
+		{[]int{}, 1, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.BinarySearchSlicePred(tc.haystack, tc.needle, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_BinarySearchIteratorPred(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		needle   int
+		exp      int
+		expLower int
+		expUpper int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 2, 2, 3}, 2, 1, 1, 4, nil, "Found"},
+		{[]int{1, 2, 3}, 3, 2, 2, 3, nil, "Found at end"},
+		{[]int{1, 3, 5}, 4, 0, 2, 2, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{}, 1, 0, 0, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			list := arraylist.NewFromSlice(tc.haystack)
+
+			res, err := goaoi.BinarySearchIteratorPred[int, int](list.Begin(), tc.needle, functional.IsLessThan[int])
+			lower, _ := goaoi.LowerBoundIteratorPred[int, int](list.Begin(), tc.needle, functional.IsLessThan[int])
+			upper, _ := goaoi.UpperBoundIteratorPred[int, int](list.Begin(), tc.needle, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.expLower, lower)
+			assert.Equal(t, tc.expUpper, upper)
+			if tc.err == nil {
+				assert.Nil(t, err)
+
+				it := list.Begin()
+				_, _ = goaoi.BinarySearchIteratorPred[int, int](it, tc.needle, functional.IsLessThan[int])
+				value, _ := it.Get()
+				assert.Equal(t, tc.needle, value)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SetUnionSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[]int{1, 3, 5}, []int{2, 3, 4}, []int{1, 2, 3, 4, 5}, nil, "Overlapping"},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, []int{1, 1, 2, 2}, nil, "Duplicates"},
+		{[]int{1, 2}, []int{}, []int{1, 2}, nil, "Second empty"},
+		{[]int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SetUnionSlicePred(tc.iterable1, tc.iterable2, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SetIntersectionSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[]int{1, 3, 5}, []int{2, 3, 4, 5}, []int{3, 5}, nil, "Overlapping"},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, []int{1, 2}, nil, "Duplicates"},
+		{[]int{1, 2}, []int{3, 4}, []int{}, nil, "Disjoint"},
+		{[]int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SetIntersectionSlicePred(tc.iterable1, tc.iterable2, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SetDifferenceSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[]int{1, 3, 5}, []int{2, 3, 4}, []int{1, 5}, nil, "Overlapping"},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, []int{1}, nil, "Duplicates"},
+		{[]int{1, 2}, []int{1, 2}, []int{}, nil, "Equal"},
+		{[]int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SetDifferenceSlicePred(tc.iterable1, tc.iterable2, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SetSymmetricDifferenceSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[]int{1, 3, 5}, []int{2, 3, 4}, []int{1, 2, 4, 5}, nil, "Overlapping"},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, []int{1, 2}, nil, "Duplicates"},
+		{[]int{1, 2}, []int{1, 2}, []int{}, nil, "Equal"},
+		{[]int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SetSymmetricDifferenceSlicePred(tc.iterable1, tc.iterable2, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_IncludesSlicePred(t *testing.T) {
+	tcs := []struct {
+		super []int
+		sub   []int
+		err   error
+		name  string
+	}{
+		{[]int{1, 2, 3, 4}, []int{2, 4}, nil, "Included"},
+		{[]int{1, 2, 3, 4}, []int{1, 2, 3, 4}, nil, "Equal"},
+		{[]int{1, 2, 4}, []int{2, 3, 4}, goaoi.ComparisonError[int, int]{BadItemIndex: 1, BadItem: 3}, "Missing in middle"},
+		{[]int{1, 2}, []int{2, 2}, goaoi.ComparisonError[int, int]{BadItemIndex: 1, BadItem: 2}, "Missing duplicate"},
+		{[]int{1, 2}, []int{2, 5}, goaoi.ComparisonError[int, int]{BadItemIndex: 1, BadItem: 5}, "Missing at end"},
+		{[]int{}, []int{1}, goaoi.EmptyIterableError{}, "Super empty"},
+		{[]int{1}, []int{}, goaoi.EmptyIterableError{}, "Sub empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.IncludesSlicePred(tc.super, tc.sub, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func Test_SetOperationIterators(t *testing.T) {
+	tcs := []struct {
+		iterable1    []int
+		iterable2    []int
+		expUnion     []int
+		expIntersect []int
+		expDiff      []int
+		expSymDiff   []int
+		err          error
+		name         string
+	}{
+		{[]int{1, 3, 5}, []int{2, 3, 4}, []int{1, 2, 3, 4, 5}, []int{3}, []int{1, 5}, []int{1, 2, 4, 5}, nil, "Overlapping"},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, []int{1, 1, 2, 2}, []int{1, 2}, []int{1}, []int{1, 2}, nil, "Duplicates"},
+		{[]int{1, 2}, []int{}, []int{1, 2}, []int{}, []int{1, 2}, []int{1, 2}, nil, "Second empty"},
+		{[]int{}, []int{1, 2}, []int{1, 2}, []int{}, []int{}, []int{1, 2}, nil, "First empty"},
+		{[]int{}, []int{}, []int{}, []int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			operations := []struct {
+				operation func(ds.ReadForIndexIterator[int, int], ds.ReadForIndexIterator[int, int], func(int, int) bool) (ds.ReadForIndexIterator[int, int], error)
+				exp       []int
+			}{
+				{goaoi.SetUnionIterator[int, int], tc.expUnion},
+				{goaoi.SetIntersectionIterator[int, int], tc.expIntersect},
+				{goaoi.SetDifferenceIterator[int, int], tc.expDiff},
+				{goaoi.SetSymmetricDifferenceIterator[int, int], tc.expSymDiff},
+			}
+
+			for _, operation := range operations {
+				it1 := arraylist.NewFromSlice(tc.iterable1).Begin()
+				it2 := arraylist.NewFromSlice(tc.iterable2).Begin()
+
+				outIter, err := operation.operation(it1, it2, functional.IsLessThan[int])
+				if tc.err == nil {
+					assert.Nil(t, err)
+
+					res := arraylist.NewFromIterator[int](outIter).GetSlice()
+					assert.Equal(t, operation.exp, res)
+				} else {
+					assert.ErrorAs(t, err, &tc.err)
+				}
+			}
+		})
+	}
+}
+
+func Test_MergeSlicePred(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+
+	tcs := []struct {
+		iterable1 []pair
+		iterable2 []pair
+		exp       []pair
+		err       error
+		name      string
+	}{
+		{[]pair{{1, "a"}, {3, "a"}}, []pair{{2, "b"}, {4, "b"}}, []pair{{1, "a"}, {2, "b"}, {3, "a"}, {4, "b"}}, nil, "Interleaved"},
+		{[]pair{{1, "a"}, {2, "a"}}, []pair{{1, "b"}, {2, "b"}}, []pair{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}, nil, "Equal keys are stable"},
+		{[]pair{}, []pair{{1, "b"}}, []pair{{1, "b"}}, nil, "First empty"},
+		{[]pair{}, []pair{}, []pair{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.MergeSlicePred(tc.iterable1, tc.iterable2, func(a pair, b pair) bool { return a.key < b.key })
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_MergeIterator(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+
+	tcs := []struct {
+		originals [][]pair
+		exp       []pair
+		err       error
+		name      string
+	}{
+		{[][]pair{}, []pair{}, goaoi.EmptyIterableError{}, "No originals"},
+		{[][]pair{{}, {}}, []pair{}, goaoi.EmptyIterableError{}, "Empty originals"},
+		{[][]pair{{}, {{1, "b"}}}, []pair{{1, "b"}}, nil, "One empty original"},
+		{[][]pair{{{1, "a"}, {4, "a"}}, {{2, "b"}}, {{0, "c"}, {3, "c"}}}, []pair{{0, "c"}, {1, "a"}, {2, "b"}, {3, "c"}, {4, "a"}}, nil, "Three originals"},
+		{[][]pair{{{1, "a"}, {1, "a2"}}, {{1, "b"}}, {{0, "c"}, {1, "c"}}}, []pair{{0, "c"}, {1, "a"}, {1, "a2"}, {1, "b"}, {1, "c"}}, nil, "Equal keys are stable"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			iterators := make([]ds.ReadForIndexIterator[int, pair], 0)
+
+			for _, original := range tc.originals {
+				iterators = append(iterators, arraylist.NewFromSlice(original).Begin())
+			}
+
+			outIter, err := goaoi.MergeIterator(func(a pair, b pair) bool { return a.key < b.key }, iterators...)
+			res := arraylist.NewFromIterator[pair](outIter).GetSlice()
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+		})
+	}
+}
+
+func Test_MakeHeapSlicePred(t *testing.T) {
+	tcs := []struct {
+		original   []int
+		comparator func(int, int) bool
+		expTop     int
+		err        error
+		name       string
+	}{
+		{[]int{3, 1, 4, 1, 5, 9, 2, 6}, functional.IsLessThan[int], 9, nil, "Max heap"},
+		{[]int{3, 1, 4, 1, 5, 9, 2, 6}, functional.IsGreaterThan[int], 1, nil, "Min heap"},
+		{[]int{1}, functional.IsLessThan[int], 1, nil, "Single element"},
+		{[]int{}, functional.IsLessThan[int], 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.MakeHeapSlicePred(&tc.original, tc.comparator)
+
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expTop, tc.original[0])
+				assert.Nil(t, goaoi.IsHeapSlicePred(tc.original, tc.comparator))
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_PushPopHeapSlicePred(t *testing.T) {
+	tcs := []struct {
+		values []int
+		exp    []int
+		name   string
+	}{
+		{[]int{3, 1, 4, 1, 5, 9, 2, 6}, []int{9, 6, 5, 4, 3, 2, 1, 1}, "Multiple"},
+		{[]int{1}, []int{1}, "Single element"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			heap := []int{}
+
+			for _, value := range tc.values {
+				goaoi.PushHeapSlicePred(&heap, value, functional.IsLessThan[int])
+				assert.Nil(t, goaoi.IsHeapSlicePred(heap, functional.IsLessThan[int]))
+			}
+
+			res := make([]int, 0, len(tc.values))
+			for len(heap) > 0 {
+				value, err := goaoi.PopHeapSlicePred(&heap, functional.IsLessThan[int])
+
+				assert.Nil(t, err)
+				res = append(res, value)
+			}
+
+			assert.Equal(t, tc.exp, res)
+
+			_, err := goaoi.PopHeapSlicePred(&heap, functional.IsLessThan[int])
+			assert.ErrorIs(t, err, goaoi.EmptyIterableError{})
+		})
+	}
+}
+
+func Test_SortHeapSlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{3, 1, 4, 1, 5, 9, 2, 6}, []int{1, 1, 2, 3, 4, 5, 6, 9}, nil, "Multiple"},
+		{[]int{1}, []int{1}, nil, "Single element"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_ = goaoi.MakeHeapSlicePred(&tc.original, functional.IsLessThan[int])
+			err := goaoi.SortHeapSlicePred(&tc.original, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_IsHeapUntilSlicePred(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       int
+		err       error
+		name      string
+	}{
+		{[]int{9, 5, 6, 1, 2}, 5, nil, "Heap"},
+		{[]int{9, 5, 6, 7, 2}, 3, goaoi.ComparisonError[int, int]{BadItemIndex: 3, BadItem: 7}, "Not a heap"},
+		{[]int{1}, 1, nil, "Single element"},
+		{[]int{}, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.IsHeapUntilSlicePred(tc.container, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, tc.err, err)
+			}
+
+		})
+	}
+}
+
+func Test_NextPermutationSlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3}, []int{1, 3, 2}, nil, "First permutation"},
+		{[]int{1, 3, 2}, []int{2, 1, 3}, nil, "Middle permutation"},
+		{[]int{1, 1, 2}, []int{1, 2, 1}, nil, "Equal elements"},
+		{[]int{3, 2, 1}, []int{1, 2, 3}, goaoi.ElementNotFoundError{}, "Last permutation wraps around"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.NextPermutationSlicePred(tc.original, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_PrevPermutationSlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{3, 2, 1}, []int{3, 1, 2}, nil, "Last permutation"},
+		{[]int{2, 1, 3}, []int{1, 3, 2}, nil, "Middle permutation"},
+		{[]int{1, 2, 3}, []int{3, 2, 1}, goaoi.ElementNotFoundError{}, "First permutation wraps around"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.PrevPermutationSlicePred(tc.original, functional.IsLessThan[int])
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_IsPermutationSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3}, []int{3, 1, 2}, nil, "Permutation"},
+		{[]int{1, 1, 2}, []int{1, 2, 1}, nil, "Permutation with duplicates"},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, goaoi.ComparisonError[int, int]{BadItemIndex: 0, BadItem: 1}, "Different counts"},
+		{[]int{1, 2}, []int{1, 2, 3}, goaoi.ComparisonError[int, int]{BadItemIndex: 2, BadItem: 3}, "Additional element"},
+		{[]int{}, []int{1}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.IsPermutationSlicePred(tc.iterable1, tc.iterable2, functional.AreEqual[int])
+
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func Test_PartitionSlice(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6}, 3, nil, "Mixed"},
+		{[]int{2, 4}, 2, nil, "All satisfy"},
+		{[]int{1, 3}, 0, nil, "None satisfy"},
+		{[]int{}, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			isEven := func(i int) bool { return i%2 == 0 }
+			res, err := goaoi.PartitionSlice(tc.original, isEven)
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, goaoi.IsPartitionedSlice(tc.original, isEven))
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_StablePartitionSlice(t *testing.T) {
+	tcs := []struct {
+		original    []int
+		exp         int
+		expOriginal []int
+		err         error
+		name        string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6}, 3, []int{2, 4, 6, 1, 3, 5}, nil, "Mixed"},
+		{[]int{2, 4}, 2, []int{2, 4}, nil, "All satisfy"},
+		{[]int{1, 3}, 0, []int{1, 3}, nil, "None satisfy"},
+		{[]int{}, 0, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.StablePartitionSlice(tc.original, func(i int) bool { return i%2 == 0 })
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.expOriginal, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_PartitionPointSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       int
+		err       error
+		name      string
+	}{
+		{[]int{2, 4, 6, 1, 3}, 3, nil, "Mixed"},
+		{[]int{2, 4}, 2, nil, "All satisfy"},
+		{[]int{1, 3}, 0, nil, "None satisfy"},
+		{[]int{}, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.PartitionPointSlice(tc.container, func(i int) bool { return i%2 == 0 })
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_IsPartitionedSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		err       error
+		name      string
+	}{
+		{[]int{2, 4, 6, 1, 3}, nil, "Partitioned"},
+		{[]int{1, 3}, nil, "None satisfy"},
+		{[]int{2, 1, 4, 3}, goaoi.ComparisonError[int, int]{BadItemIndex: 2, BadItem: 4}, "Not partitioned"},
+		{[]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.IsPartitionedSlice(tc.container, func(i int) bool { return i%2 == 0 })
+
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func Test_PartitionCopySlice(t *testing.T) {
+	tcs := []struct {
+		original    []int
+		expAccepted []int
+		expRejected []int
+		err         error
+		name        string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}, []int{1, 3, 5}, nil, "Mixed"},
+		{[]int{2, 4}, []int{2, 4}, []int{}, nil, "All satisfy"},
+		{[]int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			accepted, rejected, err := goaoi.PartitionCopySlice(tc.original, func(i int) bool { return i%2 == 0 })
+
+			assert.Equal(t, tc.expAccepted, accepted)
+			assert.Equal(t, tc.expRejected, rejected)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_PartitionMap(t *testing.T) {
+	tcs := []struct {
+		original    map[string]int
+		expAccepted map[string]int
+		expRejected map[string]int
+		err         error
+		name        string
+	}{
+		{map[string]int{"a": 1, "b": 2, "c": 3}, map[string]int{"b": 2}, map[string]int{"a": 1, "c": 3}, nil, "Mixed"},
+		{map[string]int{}, map[string]int{}, map[string]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			accepted, rejected, err := goaoi.PartitionMap(tc.original, func(i int) bool { return i%2 == 0 })
+
+			assert.Equal(t, tc.expAccepted, accepted)
+			assert.Equal(t, tc.expRejected, rejected)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_ReverseSlice(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3}, []int{3, 2, 1}, nil, "Odd length"},
+		{[]int{1, 2, 3, 4}, []int{4, 3, 2, 1}, nil, "Even length"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.ReverseSlice(tc.original)
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_RotateSlice(t *testing.T) {
+	tcs := []struct {
+		original    []int
+		n           int
+		exp         int
+		expOriginal []int
+		err         error
+		name        string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, 3, []int{3, 4, 5, 1, 2}, nil, "Rotate left"},
+		{[]int{1, 2, 3, 4, 5}, -1, 1, []int{5, 1, 2, 3, 4}, nil, "Rotate right"},
+		{[]int{1, 2, 3, 4, 5}, 7, 3, []int{3, 4, 5, 1, 2}, nil, "Rotate more than length"},
+		{[]int{1, 2, 3}, 0, 0, []int{1, 2, 3}, nil, "Rotate by 0"},
+		{[]int{}, 1, 0, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.RotateSlice(tc.original, tc.n)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.expOriginal, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_ShuffleSlice(t *testing.T) {
+	tcs := []struct {
+		original []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8}, nil, "Multiple"},
+		{[]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			shuffled1 := append([]int{}, tc.original...)
+			shuffled2 := append([]int{}, tc.original...)
+
+			err := goaoi.ShuffleSlice(shuffled1, rand.NewSource(42))
+			_ = goaoi.ShuffleSlice(shuffled2, rand.NewSource(42))
+
+			assert.Equal(t, shuffled1, shuffled2)
+			assert.ElementsMatch(t, tc.original, shuffled1)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SampleSlice(t *testing.T) {
+	tcs := []struct {
+		population []int
+		n          int
+		expLen     int
+		err        error
+		name       string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8}, 3, 3, nil, "Sample 3"},
+		{[]int{1, 2, 3}, 5, 3, nil, "Sample more than exists"},
+		{[]int{1, 2, 3}, 0, 0, nil, "Sample 0"},
+		{[]int{}, 1, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SampleSlice(tc.population, tc.n, rand.NewSource(42))
+			resAgain, _ := goaoi.SampleSlice(tc.population, tc.n, rand.NewSource(42))
+			resIterator, errIterator := goaoi.SampleIterator[int, int](arraylist.NewFromSlice(tc.population).Begin(), tc.n, rand.NewSource(42))
+
+			assert.Len(t, res, tc.expLen)
+			assert.Equal(t, res, resAgain)
+			assert.Subset(t, tc.population, res)
+			assert.Equal(t, res, resIterator)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_UniqueSlicePred(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 1, 2, 2, 2, 3, 1, 1}, []int{1, 2, 3, 1}, nil, "Consecutive duplicates"},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, nil, "No duplicates"},
+		{[]int{1, 1, 1}, []int{1}, nil, "All equal"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resCopy, errCopy := goaoi.UniqueCopySlicePred(tc.original, functional.AreEqual[int])
+			res, err := goaoi.UniqueSlicePred(tc.original, functional.AreEqual[int])
+
+			assert.Equal(t, tc.exp, tc.original[:res])
+			assert.Equal(t, tc.exp, resCopy)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errCopy)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errCopy, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_UniqueIterator(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 1, 2, 2, 2, 3, 1, 1}, []int{1, 2, 3, 1}, nil, "Consecutive duplicates"},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, nil, "No duplicates"},
+		{[]int{1, 1, 1}, []int{1}, nil, "All equal"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it := arraylist.NewFromSlice(tc.original).Begin()
+			outIter, err := goaoi.UniqueIterator[int, int](it, functional.AreEqual[int])
+			res := arraylist.NewFromIterator[int](outIter).GetSlice()
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_RemoveIfSlice(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3, 4, 5}, []int{1, 3, 5}, nil, "Some removed"},
+		{[]int{2, 4}, []int{}, nil, "All removed"},
+		{[]int{1, 3}, []int{1, 3}, nil, "None removed"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			isEven := func(i int) bool { return i%2 == 0 }
+			erased := append([]int{}, tc.original...)
+
+			res, err := goaoi.RemoveIfSlice(tc.original, isEven)
+			errErase := goaoi.EraseIfSlice(&erased, isEven)
+
+			assert.Equal(t, tc.exp, tc.original[:res])
+			assert.Equal(t, tc.exp, erased)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errErase)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errErase, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_RemoveIfMap(t *testing.T) {
+	tcs := []struct {
+		original map[string]int
+		exp      map[string]int
+		err      error
+		name     string
+	}{
+		{map[string]int{"a": 1, "b": 2, "c": 3}, map[string]int{"a": 1, "c": 3}, nil, "Some removed"},
+		{map[string]int{"b": 2}, map[string]int{}, nil, "All removed"},
+		{map[string]int{}, map[string]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.RemoveIfMap(tc.original, func(i int) bool { return i%2 == 0 })
+
+			assert.Equal(t, tc.exp, tc.original)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_RemoveIfString(t *testing.T) {
+	tcs := []struct {
+		original string
+		exp      string
+		err      error
+		name     string
+	}{
+		{"a b c", "abc", nil, "Some removed"},
+		{"abc", "abc", nil, "None removed"},
+		{"", "", goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.RemoveIfString(tc.original, functional.AreEqualPartial(' '))
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SearchSlicePred(t *testing.T) {
+	tcs := []struct {
+		super      []int
+		sub        []int
+		comparator func(int, int) bool
+		exp        int
+		err        error
+		name       string
+	}{
+		{[]int{1, 2, 3, 4}, []int{3, 4}, functional.AreEqual[int], 2, nil, "Found at end"},
+		{[]int{1, 2, 1, 2}, []int{1, 2}, functional.AreEqual[int], 0, nil, "Found first of multiple"},
+		{[]int{1, 1, 2, 3}, []int{1, 2}, functional.AreEqual[int], 1, nil, "Found after partial match"},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, functional.AreEqual[int], 0, nil, "Found equal"},
+		{[]int{1, 2, 3}, []int{1, 4}, functional.AreEqual[int], 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{1, 2}, []int{1, 2, 3}, functional.AreEqual[int], 0, goaoi.ElementNotFoundError{}, "Sub longer than super"},
+		{[]int{}, []int{1, 2, 3}, functional.AreEqual[int], 0, goaoi.EmptyIterableError{}, "Super empty"},
+		{[]int{1, 2, 3}, []int{}, functional.AreEqual[int], 0, goaoi.EmptyIterableError{}, "Sub empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SearchSlicePred(tc.super, tc.sub, tc.comparator)
+			resIterator, errIterator := goaoi.SearchIteratorPred[int, int](arraylist.NewFromSlice(tc.super).Begin(), tc.sub, tc.comparator)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, resIterator)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SearchStringPred(t *testing.T) {
+	tcs := []struct {
+		super string
+		sub   string
+		exp   int
+		err   error
+		name  string
+	}{
+		{"abcabc", "bc", 1, nil, "Found first of multiple"},
+		{"aab", "ab", 1, nil, "Found after partial match"},
+		{"abc", "ac", 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{"", "a", 0, goaoi.EmptyIterableError{}, "Super empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SearchStringPred(tc.super, tc.sub, functional.AreEqual[byte])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SearchNSlicePred(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		n        int
+		value    int
+		exp      int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 2, 3, 2, 2, 2}, 3, 2, 4, nil, "Found after shorter run"},
+		{[]int{2, 2, 1}, 2, 2, 0, nil, "Found at beginning"},
+		{[]int{1, 2, 2, 3}, 0, 2, 0, nil, "Zero length run"},
+		{[]int{1, 2, 2, 3}, 3, 2, 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{}, 1, 2, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SearchNSlicePred(tc.haystack, tc.n, tc.value, functional.AreEqual[int])
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_LexicographicalCompareSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		exp       int
+		name      string
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0, "Equal"},
+		{[]int{1, 2, 3}, []int{1, 3}, -1, "Less"},
+		{[]int{1, 3}, []int{1, 2, 3}, 1, "Greater"},
+		{[]int{1, 2}, []int{1, 2, 3}, -1, "Prefix"},
+		{[]int{1, 2, 3}, []int{1, 2}, 1, "Extension"},
+		{[]int{}, []int{1}, -1, "First empty"},
+		{[]int{}, []int{}, 0, "Both empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cmp := func(a int, b int) int { return a - b }
+
+			assert.Equal(t, tc.exp, goaoi.LexicographicalCompareSlicePred(tc.iterable1, tc.iterable2, functional.IsLessThan[int]))
+			assert.Equal(t, tc.exp, goaoi.LexicographicalCompareSliceCmp(tc.iterable1, tc.iterable2, cmp))
+
+			resIterator, err := goaoi.LexicographicalCompareIteratorPred[int, int](arraylist.NewFromSlice(tc.iterable1).Begin(), arraylist.NewFromSlice(tc.iterable2).Begin(), functional.IsLessThan[int])
+			assert.Equal(t, tc.exp, resIterator)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func Test_LexicographicalLessSlicePred(t *testing.T) {
+	container := [][]int{{2}, {1, 2, 3}, {}, {1, 2}, {1, 3}}
+
+	err := goaoi.SortSlicePred(container, goaoi.LexicographicalLessSlicePred(functional.IsLessThan[int]))
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{}, {1, 2}, {1, 2, 3}, {1, 3}, {2}}, container)
+}
+
+func Test_EqualSlicePred(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, nil, "Equal"},
+		{[]int{1, 2, 3}, []int{1, 4, 3}, goaoi.ComparisonError[int, int]{BadItemIndex: 1, BadItem: 2}, "Different element"},
+		{[]int{1, 2, 3}, []int{1, 2}, goaoi.ComparisonError[int, int]{BadItemIndex: 2, BadItem: 3}, "Second shorter"},
+		{[]int{1, 2}, []int{1, 2, 3}, goaoi.ComparisonError[int, int]{BadItemIndex: 2, BadItem: 3}, "First shorter"},
+		{[]int{}, []int{1}, goaoi.ComparisonError[int, int]{BadItemIndex: 0, BadItem: 1}, "First empty"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Both empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.EqualSlicePred(tc.iterable1, tc.iterable2, functional.AreEqual[int])
+			errIterator := goaoi.EqualIterator[int, int](arraylist.NewFromSlice(tc.iterable1).Begin(), arraylist.NewFromSlice(tc.iterable2).Begin())
+
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.Equal(t, tc.err, err)
+				assert.Equal(t, tc.err, errIterator)
+			}
+
+		})
+	}
+}
+
+func Test_EqualMapPred(t *testing.T) {
+	tcs := []struct {
+		iterable1 map[string]int
+		iterable2 map[string]int
+		err       error
+		name      string
+	}{
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 2}, nil, "Equal"},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}, goaoi.ComparisonError[string, int]{BadItemIndex: "b", BadItem: 2}, "Different value"},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, goaoi.ComparisonError[string, int]{BadItemIndex: "b", BadItem: 2}, "Missing in second"},
+		{map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, goaoi.ComparisonError[string, int]{BadItemIndex: "b", BadItem: 2}, "Missing in first"},
+		{map[string]int{}, map[string]int{}, goaoi.EmptyIterableError{}, "Both empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := goaoi.EqualMapPred(tc.iterable1, tc.iterable2, functional.AreEqual[int])
+
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, tc.err, err)
+			}
+
+		})
+	}
+}
+
+func Test_IotaSlice(t *testing.T) {
+	container := make([]int, 4)
+
+	assert.Nil(t, goaoi.IotaSlice(container, 3))
+	assert.Equal(t, []int{3, 4, 5, 6}, container)
+
+	assert.ErrorAs(t, goaoi.IotaSlice([]float64{}, 1.5), &goaoi.EmptyIterableError{})
+}
+
+func Test_ScanSlice(t *testing.T) {
+	tcs := []struct {
+		container    []int
+		expInclusive []int
+		expExclusive []int
+		err          error
+		name         string
+	}{
+		{[]int{1, 2, 3, 4}, []int{1, 3, 6, 10}, []int{10, 11, 13, 16}, nil, "Multiple elements"},
+		{[]int{5}, []int{5}, []int{10}, nil, "Single element"},
+		{[]int{}, []int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resPartialSum, errPartialSum := goaoi.PartialSumSlice(tc.container)
+			resInclusive, errInclusive := goaoi.InclusiveScanSlice(tc.container, functional.Add[int])
+			resExclusive, errExclusive := goaoi.ExclusiveScanSlice(tc.container, 10, functional.Add[int])
+
+			itPartialSum, errPartialSumIterator := goaoi.PartialSumIterator[int, int](arraylist.NewFromSlice(tc.container).Begin())
+			itExclusive, errExclusiveIterator := goaoi.ExclusiveScanIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), 10, functional.Add[int])
+
+			assert.Equal(t, tc.expInclusive, resPartialSum)
+			assert.Equal(t, tc.expInclusive, resInclusive)
+			assert.Equal(t, tc.expExclusive, resExclusive)
+			assert.Equal(t, tc.expInclusive, arraylist.NewFromIterator[int](itPartialSum).GetSlice())
+			assert.Equal(t, tc.expExclusive, arraylist.NewFromIterator[int](itExclusive).GetSlice())
+
+			for _, err := range []error{errPartialSum, errInclusive, errExclusive, errPartialSumIterator, errExclusiveIterator} {
+				if tc.err == nil {
+					assert.Nil(t, err)
+				} else {
+					assert.ErrorAs(t, err, &tc.err)
+				}
+			}
+		})
+	}
+}
+
+func Test_AdjacentDifferenceSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[]int{2, 4, 7, 7, 1}, []int{2, 2, 3, 0, -6}, nil, "Multiple elements"},
+		{[]int{5}, []int{5}, nil, "Single element"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.AdjacentDifferenceSlice(tc.container)
+			it, errIterator := goaoi.AdjacentDifferenceIterator[int, int](arraylist.NewFromSlice(tc.container).Begin())
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[int](it).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_InnerProductSlice(t *testing.T) {
+	tcs := []struct {
+		iterable1 []int
+		iterable2 []int
+		exp       int
+		name      string
+	}{
+		{[]int{1, 2, 3}, []int{4, 5, 6}, 42, "Same length"},
+		{[]int{1, 2, 3}, []int{4}, 14, "Different length"},
+		{[]int{}, []int{}, 10, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res := goaoi.InnerProductSlice(tc.iterable1, tc.iterable2, 10)
+			resIterator, err := goaoi.InnerProductIterator[int, int](arraylist.NewFromSlice(tc.iterable1).Begin(), arraylist.NewFromSlice(tc.iterable2).Begin(), 10)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, resIterator)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func Test_TransformReduceSlice(t *testing.T) {
+	container := []string{"a", "bcd", "ef"}
+	length := func(s string) int { return len(s) }
+
+	assert.Equal(t, 6, goaoi.TransformReduceSlice(container, 0, functional.Add[int], length))
+	assert.Equal(t, 0, goaoi.TransformReduceSlice([]string{}, 0, functional.Add[int], length))
+
+	res, err := goaoi.TransformReduceIterator[int, string](arraylist.NewFromSlice(container).Begin(), 0, functional.Add[int], length)
+	assert.Equal(t, 6, res)
+	assert.Nil(t, err)
+}
+
+func sumNonNegative(accumulator float64, value int) (float64, error) {
+	if value < 0 {
+		return accumulator, assert.AnError
+	}
+
+	return accumulator + float64(value), nil
+}
+
+func Test_FoldSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       float64
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3}, 6.5, nil, "Multiple elements"},
+		{[]int{1, -2, 3}, 1.5, goaoi.ExecutionError[int, int]{BadItemIndex: 1, BadItem: -2, Inner: assert.AnError}, "Error"},
+		{[]int{}, 0.5, nil, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FoldSlice(tc.container, 0.5, sumNonNegative)
+			resIterator, errIterator := goaoi.FoldIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), 0.5, sumNonNegative)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, resIterator)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.Equal(t, tc.err, err)
+				assert.Equal(t, tc.err, errIterator)
+			}
+
+		})
+	}
+}
+
+func Test_FoldMap(t *testing.T) {
+	counts, err := goaoi.FoldMap(map[string]string{"a": "x", "b": "y", "c": "x"}, map[string]int{}, func(acc map[string]int, value string) (map[string]int, error) {
+		acc[value]++
+
+		return acc, nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"x": 2, "y": 1}, counts)
+
+	_, err = goaoi.FoldMap(map[string]int{"a": -1}, 0.0, sumNonNegative)
+
+	assert.Equal(t, goaoi.ExecutionError[string, int]{BadItemIndex: "a", BadItem: -1, Inner: assert.AnError}, err)
+}
+
+func Test_FoldString(t *testing.T) {
+	appendRune := func(acc []rune, value rune) ([]rune, error) {
+		if value == '!' {
+			return acc, assert.AnError
+		}
+
+		return append(acc, value), nil
+	}
+
+	res, err := goaoi.FoldString("aäb", []rune{}, appendRune)
+	assert.Nil(t, err)
+	assert.Equal(t, []rune("aäb"), res)
+
+	res, err = goaoi.FoldRightString("aäb", []rune{}, appendRune)
+	assert.Nil(t, err)
+	assert.Equal(t, []rune("bäa"), res)
+
+	res, err = goaoi.FoldRightString("aä!b", []rune{}, appendRune)
+	assert.Equal(t, []rune("b"), res)
+	assert.Equal(t, goaoi.ExecutionError[int, rune]{BadItemIndex: 3, BadItem: '!', Inner: assert.AnError}, err)
+}
+
+func Test_FoldRightSlice(t *testing.T) {
+	appendInt := func(acc []int, value int) ([]int, error) {
+		if value < 0 {
+			return acc, assert.AnError
+		}
+
+		return append(acc, value), nil
+	}
+
+	res, err := goaoi.FoldRightSlice([]int{1, 2, 3}, []int{}, appendInt)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3, 2, 1}, res)
+
+	res, err = goaoi.FoldRightSlice([]int{1, -2, 3}, []int{}, appendInt)
+	assert.Equal(t, []int{3}, res)
+	assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 1, BadItem: -2, Inner: assert.AnError}, err)
+}
+
+func Test_ReduceSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3}, 6, nil, "Multiple elements"},
+		{[]int{4}, 4, nil, "Single element"},
+		{[]int{1, 2, -3, 4}, 3, goaoi.ExecutionError[int, int]{BadItemIndex: 2, BadItem: -3, Inner: assert.AnError}, "Error"},
+		{[]int{}, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			add := func(a int, b int) (int, error) {
+				if b < 0 {
+					return a, assert.AnError
+				}
+
+				return a + b, nil
+			}
+			res, err := goaoi.ReduceSlice(tc.container, add)
+			resIterator, errIterator := goaoi.ReduceIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), add)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, resIterator)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.Equal(t, tc.err, err)
+				assert.Equal(t, tc.err, errIterator)
+			}
+		})
+	}
+}
+
+func Test_ReduceMap(t *testing.T) {
+	res, err := goaoi.ReduceMap(map[string]int{"a": 1, "b": 2, "c": 3}, func(a int, b int) (int, error) { return a + b, nil })
+
+	assert.Nil(t, err)
+	assert.Equal(t, 6, res)
+
+	_, err = goaoi.ReduceMap(map[string]int{}, func(a int, b int) (int, error) { return a + b, nil })
+
+	assert.ErrorAs(t, err, &goaoi.EmptyIterableError{})
+}
+
+func Test_ZipIterator(t *testing.T) {
+	tcs := []struct {
+		iterable1  []int
+		iterable2  []string
+		exp        []tuple.T2[int, string]
+		expLongest []tuple.T2[int, string]
+		err        error
+		errLongest error
+		name       string
+	}{
+		{[]int{1, 2}, []string{"a", "b"}, []tuple.T2[int, string]{tuple.New2(1, "a"), tuple.New2(2, "b")}, []tuple.T2[int, string]{tuple.New2(1, "a"), tuple.New2(2, "b")}, nil, nil, "Same length"},
+		{[]int{1, 2, 3}, []string{"a"}, []tuple.T2[int, string]{tuple.New2(1, "a")}, []tuple.T2[int, string]{tuple.New2(1, "a"), tuple.New2(2, "-"), tuple.New2(3, "-")}, nil, nil, "First longer"},
+		{[]int{1}, []string{"a", "b"}, []tuple.T2[int, string]{tuple.New2(1, "a")}, []tuple.T2[int, string]{tuple.New2(1, "a"), tuple.New2(-1, "b")}, nil, nil, "Second longer"},
+		{[]int{}, []string{"a"}, []tuple.T2[int, string]{}, []tuple.T2[int, string]{tuple.New2(-1, "a")}, goaoi.EmptyIterableError{}, nil, "One empty"},
+		{[]int{}, []string{}, []tuple.T2[int, string]{}, []tuple.T2[int, string]{}, goaoi.EmptyIterableError{}, goaoi.EmptyIterableError{}, "Both empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it, err := goaoi.ZipIterator[int, int, int, string](arraylist.NewFromSlice(tc.iterable1).Begin(), arraylist.NewFromSlice(tc.iterable2).Begin())
+			itLongest, errLongest := goaoi.ZipLongestIterator[int, int, int, string](arraylist.NewFromSlice(tc.iterable1).Begin(), arraylist.NewFromSlice(tc.iterable2).Begin(), -1, "-")
+
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[tuple.T2[int, string]](it).GetSlice())
+			assert.Equal(t, tc.expLongest, arraylist.NewFromIterator[tuple.T2[int, string]](itLongest).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+			if tc.errLongest == nil {
+				assert.Nil(t, errLongest)
+			} else {
+				assert.ErrorAs(t, errLongest, &tc.errLongest)
+			}
+
+		})
+	}
+}
+
+func Test_ZipIteratorSharedSource(t *testing.T) {
+	second := arraylist.NewFromSlice([]string{"a", "b", "c"}).Begin()
+	it, err := goaoi.ZipIterator[int, int, int, string](arraylist.NewFromSlice([]int{1}).Begin(), second)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []tuple.T2[int, string]{tuple.New2(1, "a")}, arraylist.NewFromIterator[tuple.T2[int, string]](it).GetSlice())
+
+	// The element of second after the zipped ones is not consumed.
+	assert.True(t, second.Next())
+	value, _ := second.Get()
+	assert.Equal(t, "b", value)
+}
+
+func Test_ZipNIterator(t *testing.T) {
+	tcs := []struct {
+		originals  [][]int
+		exp        [][]int
+		expLongest [][]int
+		err        error
+		errLongest error
+		name       string
+	}{
+		{[][]int{{1, 2}, {3, 4, 5}, {6, 7}}, [][]int{{1, 3, 6}, {2, 4, 7}}, [][]int{{1, 3, 6}, {2, 4, 7}, {0, 5, 0}}, nil, nil, "Three originals"},
+		{[][]int{{1, 2}}, [][]int{{1}, {2}}, [][]int{{1}, {2}}, nil, nil, "Single original"},
+		{[][]int{{1}, {}}, [][]int{}, [][]int{{1, 0}}, goaoi.EmptyIterableError{}, nil, "One empty"},
+		{[][]int{}, [][]int{}, [][]int{}, goaoi.EmptyIterableError{}, goaoi.EmptyIterableError{}, "No originals"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			originals := make([]ds.ReadForIndexIterator[int, int], 0, len(tc.originals))
+			originalsLongest := make([]ds.ReadForIndexIterator[int, int], 0, len(tc.originals))
+			for _, original := range tc.originals {
+				originals = append(originals, arraylist.NewFromSlice(original).Begin())
+				originalsLongest = append(originalsLongest, arraylist.NewFromSlice(original).Begin())
+			}
+
+			it, err := goaoi.ZipNIterator(originals...)
+			itLongest, errLongest := goaoi.ZipNLongestIterator(0, originalsLongest...)
+
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[[]int](it).GetSlice())
+			assert.Equal(t, tc.expLongest, arraylist.NewFromIterator[[]int](itLongest).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+			if tc.errLongest == nil {
+				assert.Nil(t, errLongest)
+			} else {
+				assert.ErrorAs(t, errLongest, &tc.errLongest)
+			}
+
+		})
+	}
+}
+
+func Test_UnzipIterator(t *testing.T) {
+	pairs := []tuple.T2[int, string]{tuple.New2(1, "a"), tuple.New2(2, "b"), tuple.New2(3, "c")}
+
+	first, second, err := goaoi.UnzipIterator[int, int, string](arraylist.NewFromSlice(pairs).Begin())
+	assert.Nil(t, err)
+
+	// Advance both sides in an interleaved order to exercise the shared buffer
+	assert.True(t, first.NextN(2))
+	value1, _ := first.Get()
+	assert.Equal(t, 2, value1)
+
+	assert.True(t, second.Next())
+	value2, _ := second.Get()
+	assert.Equal(t, "a", value2)
+
+	assert.Equal(t, []string{"b", "c"}, arraylist.NewFromIterator[string](second).GetSlice())
+	assert.Equal(t, []int{3}, arraylist.NewFromIterator[int](first).GetSlice())
+
+	_, _, err = goaoi.UnzipIterator[int, int, string](arraylist.NewFromSlice([]tuple.T2[int, string]{}).Begin())
+	assert.ErrorAs(t, err, &goaoi.EmptyIterableError{})
+}
+
+func Test_UnzipIteratorBuffered(t *testing.T) {
+	pairs := make([]tuple.T2[int, string], 0, 100)
+	firsts := make([]int, 0, 100)
+	seconds := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		pairs = append(pairs, tuple.New2(i, strconv.Itoa(i)))
+		firsts = append(firsts, i)
+		seconds = append(seconds, strconv.Itoa(i))
+	}
+
+	// Reading one side completely buffers all elements of the other one
+	first, second, err := goaoi.UnzipIterator[int, int, string](arraylist.NewFromSlice(pairs).Begin())
+	assert.Nil(t, err)
+	assert.Equal(t, firsts, arraylist.NewFromIterator[int](first).GetSlice())
+	assert.Equal(t, seconds, arraylist.NewFromIterator[string](second).GetSlice())
+
+	// Letting the second side trail behind keeps consuming and refilling the buffer
+	first, second, _ = goaoi.UnzipIterator[int, int, string](arraylist.NewFromSlice(pairs).Begin())
+	resFirst := []int{}
+	resSecond := []string{}
+	for first.NextN(2) {
+		value1, _ := first.Get()
+		resFirst = append(resFirst, value1)
+
+		assert.True(t, second.Next())
+		value2, _ := second.Get()
+		resSecond = append(resSecond, value2)
+	}
+
+	resSecond = append(resSecond, arraylist.NewFromIterator[string](second).GetSlice()...)
+
+	assert.Equal(t, 50, len(resFirst))
+	assert.Equal(t, 99, resFirst[49])
+	assert.Equal(t, seconds, resSecond)
+}
+
+func Test_EnumerateIterator(t *testing.T) {
+	it, err := goaoi.EnumerateIterator[int, string](arraylist.NewFromSlice([]string{"a", "b", "c"}).Begin())
+
+	assert.Nil(t, err)
+	assert.Equal(t, []tuple.T2[int, string]{tuple.New2(0, "a"), tuple.New2(1, "b"), tuple.New2(2, "c")}, arraylist.NewFromIterator[tuple.T2[int, string]](it).GetSlice())
+
+	_, err = goaoi.EnumerateIterator[int, string](arraylist.New[string]().Begin())
+
+	assert.ErrorAs(t, err, &goaoi.EmptyIterableError{})
+}
+
+func Test_ForeachSliceIndexed(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[]int{5, 6, 7}, []int{5, 7, 9}, nil, "Multiple elements"},
+		{[]int{5, -1, 7}, []int{5}, goaoi.ExecutionError[int, int]{BadItemIndex: 1, BadItem: -1, Inner: assert.AnError}, "Error"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			addIndex := func(i int, value int) (int, error) {
+				if value < 0 {
+					return 0, assert.AnError
+				}
+
+				return i + value, nil
+			}
+
+			res := []int{}
+			err := goaoi.ForeachSliceIndexed(tc.container, func(i int, value int) error {
+				sum, err := addIndex(i, value)
+				if err == nil {
+					res = append(res, sum)
+				}
+
+				return err
+			})
+			resTransform, errTransform := goaoi.TransformCopySliceIndexed(tc.container, addIndex)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, resTransform)
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errTransform)
+			} else {
+				assert.Equal(t, tc.err, err)
+				assert.Equal(t, tc.err, errTransform)
+			}
+
+		})
+	}
+}
+
+func Test_ForeachMapIndexed(t *testing.T) {
+	container := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	visited := []tuple.T3[int, string, int]{}
+	err := goaoi.ForeachMapIndexed(container, func(i int, key string, value int) error {
+		visited = append(visited, tuple.New3(i, key, value))
+
+		return nil
+	})
+
+	expected := []tuple.T3[int, string, int]{tuple.New3(0, "a", 1), tuple.New3(1, "b", 2), tuple.New3(2, "c", 3)}
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, visited)
+
+	res, err := goaoi.ZipWithIndexMap(container)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, res)
+
+	err = goaoi.ForeachMapIndexed(container, func(i int, key string, value int) error {
+		if key == "b" {
+			return assert.AnError
+		}
+
+		return nil
+	})
+
+	assert.Equal(t, goaoi.ExecutionError[string, int]{BadItemIndex: "b", BadItem: 2, Inner: assert.AnError}, err)
+
+	_, err = goaoi.ZipWithIndexMap(map[string]int{})
+
+	assert.ErrorAs(t, err, &goaoi.EmptyIterableError{})
+}
+
+func Test_ChunkSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		n         int
+		exp       [][]int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}, nil, "Shorter last chunk"},
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}, nil, "Divisible"},
+		{[]int{1, 2}, 5, [][]int{{1, 2}}, nil, "Chunk larger than container"},
+		{[]int{1, 2}, 0, [][]int{}, nil, "Zero size"},
+		{[]int{}, 2, [][]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.ChunkSlice(tc.container, tc.n)
+			it, errIterator := goaoi.ChunkIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), tc.n, false)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[[]int](it).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_ChunkSliceNoCopy(t *testing.T) {
+	container := []int{1, 2, 3, 4}
+
+	chunks, err := goaoi.ChunkSlice(container, 2)
+	assert.Nil(t, err)
+
+	chunks[0][0] = 10
+	chunks[0] = append(chunks[0], 20)
+
+	assert.Equal(t, []int{10, 2, 3, 4}, container)
+}
+
+func Test_WindowSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		size      int
+		step      int
+		exp       [][]int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, nil, "Sliding"},
+		{[]int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}, nil, "Tumbling"},
+		{[]int{1, 2, 3, 4, 5, 6}, 2, 3, [][]int{{1, 2}, {4, 5}}, nil, "Step larger than size"},
+		{[]int{1, 2}, 3, 1, [][]int{}, nil, "Window larger than container"},
+		{[]int{1, 2}, 1, 0, [][]int{}, nil, "Zero step"},
+		{[]int{}, 2, 1, [][]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.WindowSlice(tc.container, tc.size, tc.step)
+			it, errIterator := goaoi.WindowIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), tc.size, tc.step, false)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[[]int](it).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_WindowIteratorReuseBuffer(t *testing.T) {
+	it, err := goaoi.WindowIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin(), 2, 1, true)
+	assert.Nil(t, err)
+
+	sums := []int{}
+	var first []int
+	for it.Next() {
+		window, _ := it.Get()
+		if first == nil {
+			first = window
+		}
+
+		// All windows share the buffer of the first one
+		assert.Same(t, &first[0], &window[0])
+
+		sums = append(sums, window[0]+window[1])
+	}
+
+	assert.Equal(t, []int{3, 5, 7}, sums)
+
+	chunks, err := goaoi.ChunkIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3}).Begin(), 2, true)
+	assert.Nil(t, err)
+
+	lengths := []int{}
+	for chunks.Next() {
+		chunk, _ := chunks.Get()
+		lengths = append(lengths, len(chunk))
+	}
+
+	assert.Equal(t, []int{2, 1}, lengths)
+}
+
+func Test_PairwiseIterator(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       []tuple.T2[int, int]
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3}, []tuple.T2[int, int]{tuple.New2(1, 2), tuple.New2(2, 3)}, nil, "Multiple elements"},
+		{[]int{1}, []tuple.T2[int, int]{}, nil, "Single element"},
+		{[]int{}, []tuple.T2[int, int]{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it, err := goaoi.PairwiseIterator[int, int](arraylist.NewFromSlice(tc.container).Begin())
+
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[tuple.T2[int, int]](it).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SplitNSlice(t *testing.T) {
+	tcs := []struct {
+		container []int
+		m         int
+		exp       [][]int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{1, 2, 3}, {4, 5}, {6, 7}}, nil, "Uneven"},
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}, nil, "Even"},
+		{[]int{1, 2}, 3, [][]int{{1}, {2}, {}}, nil, "More parts than elements"},
+		{[]int{1, 2}, 0, [][]int{}, nil, "No parts"},
+		{[]int{}, 2, [][]int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.SplitNSlice(tc.container, tc.m)
+
+			// Random access original
+			parts, errIterator := goaoi.SplitNIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), tc.m)
+			resIterator := [][]int{}
+			for _, part := range parts {
+				resIterator = append(resIterator, arraylist.NewFromIterator[int](part).GetSlice())
+			}
+
+			// Forward only original
+			unique, _ := goaoi.UniqueIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), functional.AreEqual[int])
+			partsForward, _ := goaoi.SplitNIterator(unique, tc.m)
+			resForward := [][]int{}
+			for _, part := range partsForward {
+				resForward = append(resForward, arraylist.NewFromIterator[int](part).GetSlice())
+			}
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Equal(t, tc.exp, resIterator)
+				assert.Equal(t, tc.exp, resForward)
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.Empty(t, parts)
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SplitNIteratorOutOfOrder(t *testing.T) {
+	parts, err := goaoi.SplitNIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4, 5, 6}).Begin(), 3)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{5, 6}, arraylist.NewFromIterator[int](parts[2]).GetSlice())
+	assert.Equal(t, []int{1, 2}, arraylist.NewFromIterator[int](parts[0]).GetSlice())
+	assert.Equal(t, []int{3, 4}, arraylist.NewFromIterator[int](parts[1]).GetSlice())
+}
+
+func Test_SplitNIteratorPipeline(t *testing.T) {
+	valuesOrig := arraylist.NewFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15})
+
+	valid, err := goaoi.TakeWhileIterator[int, int](valuesOrig.Begin(), functional.IsLessThanEqualPartial(12))
+	assert.Nil(t, err)
+
+	parts, err := goaoi.SplitNIterator(valid, 4)
+	assert.Nil(t, err)
+
+	newParts := make([]ds.ReadForIndexIterator[int, int], 0, len(parts))
+	for _, part := range parts {
+		newPart, err := goaoi.TransformIterator(part, func(i int) (int, error) { return i + 5, nil })
+		assert.Nil(t, err)
+
+		newParts = append(newParts, newPart)
+	}
+
+	joined, err := goaoi.JoinIterator(newParts...)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, arraylist.NewFromIterator[int](joined).GetSlice())
+	assert.Equal(t, 15, valuesOrig.Size())
+}
+
+func Test_SplitWhenIterator(t *testing.T) {
+	tcs := []struct {
+		container []int
+		exp       [][]int
+		err       error
+		name      string
+	}{
+		{[]int{1, 2, 0, 3, 0, 4, 5}, [][]int{{1, 2}, {3}, {4, 5}}, nil, "Separators in middle"},
+		{[]int{0, 1, 0, 0}, [][]int{{}, {1}, {}, {}}, nil, "Separators at ends"},
+		{[]int{1, 2}, [][]int{{1, 2}}, nil, "No separator"},
+		{[]int{}, [][]int{{}}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it, err := goaoi.SplitWhenIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), functional.AreEqualPartial(0))
+
+			res := [][]int{}
+			for it.Next() {
+				part, _ := it.Get()
+				res = append(res, arraylist.NewFromIterator[int](part).GetSlice())
+			}
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_SplitWhenIteratorSkipsPart(t *testing.T) {
+	it, err := goaoi.SplitWhenIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 0, 3, 4}).Begin(), functional.AreEqualPartial(0))
+	assert.Nil(t, err)
+
+	assert.True(t, it.NextN(2))
+	part, _ := it.Get()
+
+	assert.Equal(t, []int{3, 4}, arraylist.NewFromIterator[int](part).GetSlice())
+	assert.False(t, it.Next())
+}
+
+func Test_FlattenSlice(t *testing.T) {
+	tcs := []struct {
+		container [][]int
+		exp       []int
+		err       error
+		name      string
+	}{
+		{[][]int{{1, 2}, {}, {3}}, []int{1, 2, 3}, nil, "Multiple slices"},
+		{[][]int{{}, {}}, []int{}, nil, "Only empty slices"},
+		{[][]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FlattenSlice(tc.container)
+
+			inner := make([]ds.ReadForIndexIterator[int, int], 0, len(tc.container))
+			for _, slice := range tc.container {
+				inner = append(inner, arraylist.NewFromSlice(slice).Begin())
+			}
+			it, errIterator := goaoi.FlattenIterator[int, int, int](arraylist.NewFromSlice(inner).Begin())
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[int](it).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, errIterator)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+				assert.ErrorAs(t, errIterator, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_FlattenSliceNested(t *testing.T) {
+	nested := [][][]int{{{1, 2}, {3}}, {}, {{4}}}
+
+	once, err := goaoi.FlattenSlice(nested)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {3}, {4}}, once)
+
+	twice, err := goaoi.FlattenSlice(once)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, twice)
+}
+
+func Test_FlattenSliceDepth(t *testing.T) {
+	tcs := []struct {
+		container []any
+		depth     int
+		exp       []any
+		err       error
+		name      string
+	}{
+		{[]any{1, []any{2, []any{3, []any{4}}}}, 1, []any{1, 2, []any{3, []any{4}}}, nil, "Depth 1"},
+		{[]any{1, []any{2, []any{3, []any{4}}}}, 2, []any{1, 2, 3, []any{4}}, nil, "Depth 2"},
+		{[]any{1, []any{2, []any{3, []any{4}}}}, -1, []any{1, 2, 3, 4}, nil, "Unlimited"},
+		{[]any{1, []any{2}}, 0, []any{1, []any{2}}, nil, "Depth 0"},
+		{[]any{[]int{1, 2}, []any{"a"}}, -1, []any{1, 2, "a"}, nil, "Typed slice"},
+		{[]any{}, 1, []any{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FlattenSliceDepth(tc.container, tc.depth)
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_FlattenSliceDepthTyped(t *testing.T) {
+	container := [][][]int{{{1, 2}, {3}}, {{4}}}
+
+	tcs := []struct {
+		depth int
+		exp   []any
+		name  string
+	}{
+		{0, []any{[][]int{{1, 2}, {3}}, [][]int{{4}}}, "Depth 0"},
+		{1, []any{[]int{1, 2}, []int{3}, []int{4}}, "Depth 1"},
+		{2, []any{1, 2, 3, 4}, "Depth 2"},
+		{-1, []any{1, 2, 3, 4}, "Unlimited"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FlattenSliceDepth(container, tc.depth)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func Test_FlatMapSlice(t *testing.T) {
+	tcs := []struct {
+		container   []int
+		transformer func(int) ([]string, error)
+		exp         []string
+		err         error
+		name        string
+	}{
+		{[]int{1, 0, 2}, func(i int) ([]string, error) { return strings.Split(strings.Repeat("x", i), ""), nil }, []string{"x", "x", "x"}, nil, "Repeat"},
+		{[]int{1, -1, 2}, func(i int) ([]string, error) {
+			if i < 0 {
+				return nil, assert.AnError
+			}
+
+			return []string{"x"}, nil
+		}, []string{"x"}, goaoi.ExecutionError[int, int]{BadItemIndex: 1, BadItem: -1, Inner: assert.AnError}, "Error"},
+		{[]int{}, func(i int) ([]string, error) { return []string{}, nil }, []string{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FlatMapSlice(tc.container, tc.transformer)
+			it, errIterator := goaoi.FlatMapIterator[int, int](arraylist.NewFromSlice(tc.container).Begin(), tc.transformer)
+			itLazy, _ := goaoi.FlatMapIteratorLazy[int, int](arraylist.NewFromSlice(tc.container).Begin(), func(i int) (ds.ReadForIndexIterator[int, string], error) {
+				values, err := tc.transformer(i)
+
+				return arraylist.NewFromSlice(values).Begin(), err
+			})
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[string](it).GetSlice())
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[string](itLazy).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, tc.err, err)
+			}
+			// The lazy iterator only stops on errors of transformer
+			if _, ok := tc.err.(goaoi.EmptyIterableError); ok {
+				assert.ErrorAs(t, errIterator, &tc.err)
+			} else {
+				assert.Nil(t, errIterator)
+			}
+
+		})
+	}
+}
+
+func Test_MapIterator(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []string
+		err      error
+		errIter  error
+		name     string
+	}{
+		{[]int{1, 2, 3}, []string{"1", "2", "3"}, nil, nil, "Converted"},
+		{[]int{1, -2, 3}, []string{"1"}, nil, assert.AnError, "Transformer error"},
+		{[]int{}, []string{}, goaoi.EmptyIterableError{}, nil, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it, err := goaoi.MapIterator[int, int](arraylist.NewFromSlice(tc.original).Begin(), func(i int) (string, error) {
+				if i < 0 {
+					return "", assert.AnError
+				}
+
+				return strconv.Itoa(i), nil
+			})
+
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[string](it).GetSlice())
+			assert.Equal(t, tc.errIter, it.(interface{ Err() error }).Err())
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_IteratorErrorPropagation(t *testing.T) {
+	failAt := func(bad int) func(int) (int, error) {
+		return func(i int) (int, error) {
+			if i == bad {
+				return 0, assert.AnError
+			}
+
+			return i, nil
+		}
+	}
+
+	tcs := []struct {
+		consume func(ds.ReadForIndexIterator[int, int]) (int, error)
+		exp     int
+		name    string
+	}{
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				sum := 0
+				err := goaoi.ForeachIterator(it, func(i int) error { sum += i; return nil })
+
+				return sum, err
+			},
+			3, "Foreach",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				sum := 0
+				err := goaoi.ForeachIteratorUnsafe(it, func(i int) { sum += i })
+
+				return sum, err
+			},
+			3, "ForeachUnsafe",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.CountIfIterator(it, func(i int) bool { return i > 0 })
+			},
+			2, "CountIf",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.AccumulateIterator(it, 0, functional.Add[int])
+			},
+			3, "Accumulate",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.FoldIterator(it, 0, func(acc int, i int) (int, error) { return acc + i, nil })
+			},
+			3, "Fold",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mapped, _ := goaoi.MapIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3}).Begin(), failAt(3))
+			joined, _ := goaoi.JoinIterator[int, int](mapped, arraylist.NewFromSlice([]int{4, 5}).Begin())
+
+			res, err := tc.consume(joined)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 2, Inner: assert.AnError}, err)
+			assert.Equal(t, assert.AnError, compounditerators.ErrOf(joined))
+		})
+	}
+}
+
+func Test_IteratorErrorPropagationStrided(t *testing.T) {
+	mapped, _ := goaoi.MapIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4, 5, 6}).Begin(), func(i int) (int, error) {
+		if i == 5 {
+			return 0, assert.AnError
+		}
+
+		return i, nil
+	})
+	strided, _ := goaoi.StridedIterator(mapped, 2)
+
+	res := []int{}
+	err := goaoi.ForeachIterator(strided, func(i int) error { res = append(res, i); return nil })
+
+	assert.Equal(t, []int{1, 3}, res)
+	assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 2, Inner: assert.AnError}, err)
+}
+
+func Test_AdapterBidirectionalRandomAccess(t *testing.T) {
+	type bidRandIterator interface {
+		ds.ReadForIndexIterator[int, int]
+		ds.BidirectionalIterator
+		ds.RandomAccessReadableIterator[int, int]
+	}
+
+	tcs := []struct {
+		adapt func(ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error)
+		exp   []int
+		name  string
+	}{
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error) {
+				return goaoi.TakeNIterator(it, 4)
+			},
+			[]int{1, 2, 3, 4}, "TakeN",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error) {
+				return goaoi.DropNIterator(it, 2)
+			},
+			[]int{3, 4, 5, 6}, "DropN",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error) {
+				return goaoi.StridedIterator(it, 2)
+			},
+			[]int{1, 3, 5}, "Strided",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error) {
+				return goaoi.TransformIterator(it, func(i int) (int, error) { return i * 10, nil })
+			},
+			[]int{10, 20, 30, 40, 50, 60}, "Transform",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error) {
+				return goaoi.ReplaceIfIterator(it, func(i int) bool { return i%2 == 0 }, 0)
+			},
+			[]int{1, 0, 3, 0, 5, 0}, "ReplaceIf",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (ds.ReadForIndexIterator[int, int], error) {
+				dropped, _ := goaoi.DropNIterator(it, 1)
+
+				return goaoi.TakeNIterator(dropped, 3)
+			},
+			[]int{2, 3, 4}, "Chained",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			adapted, err := tc.adapt(arraylist.NewFromSlice([]int{1, 2, 3, 4, 5, 6}).Begin())
+			assert.Nil(t, err)
+
+			it, ok := adapted.(bidRandIterator)
+			assert.True(t, ok)
+
+			forward := []int{}
+			for it.Next() {
+				value, _ := it.Get()
+				forward = append(forward, value)
+			}
+
+			backward := []int{}
+			for it.Previous() {
+				value, _ := it.Get()
+				backward = append([]int{value}, backward...)
+			}
+
+			assert.Equal(t, tc.exp, forward)
+			assert.Equal(t, tc.exp, backward)
+			assert.True(t, it.IsBegin())
+			assert.Equal(t, len(tc.exp), it.Size())
+
+			for i, exp := range tc.exp {
+				value, found := it.GetAt(i)
+				assert.True(t, found)
+				assert.Equal(t, exp, value)
+			}
+
+			_, found := it.GetAt(len(tc.exp))
+			assert.False(t, found)
+
+			assert.True(t, it.MoveTo(len(tc.exp)-1))
+			value, _ := it.Get()
+			assert.Equal(t, tc.exp[len(tc.exp)-1], value)
+			assert.False(t, it.Next())
+			assert.True(t, it.MoveBy(-len(tc.exp)))
+			assert.True(t, it.IsFirst())
+		})
+	}
+}
+
+func Test_AdapterBinarySearch(t *testing.T) {
+	strided, _ := goaoi.StridedIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8}).Begin(), 2)
+	haystack, ok := strided.(ds.RandomAccessReadableIterator[int, int])
+	assert.True(t, ok)
+
+	i, err := goaoi.BinarySearchIteratorPred(haystack, 5, functional.IsLessThan[int])
+	assert.Nil(t, err)
+	assert.Equal(t, 2, i)
+
+	value, _ := haystack.Get()
+	assert.Equal(t, 5, value)
+
+	_, err = goaoi.BinarySearchIteratorPred(haystack, 4, functional.IsLessThan[int])
+	assert.ErrorAs(t, err, &goaoi.ElementNotFoundError{})
+}
+
+func Test_AdapterForwardOnly(t *testing.T) {
+	joined, _ := goaoi.JoinIterator[int, int](arraylist.NewFromSlice([]int{1, 2}).Begin(), arraylist.NewFromSlice([]int{3}).Begin())
+	taken, _ := goaoi.TakeNIterator(joined, 2)
+
+	_, ok := taken.(ds.BidirectionalIterator)
+	assert.False(t, ok)
+
+	_, ok = taken.(ds.RandomAccessReadableIterator[int, int])
+	assert.False(t, ok)
+}
+
+func Test_FindLastIfSlice(t *testing.T) {
+	tcs := []struct {
+		haystack   []int
+		comparator func(int) bool
+		exp        int
+		err        error
+		name       string
+	}{
+		{[]int{1, 2, 1, 3}, func(i int) bool { return i == 1 }, 2, nil, "Found"},
+		{[]int{1, 2}, func(i int) bool { return i == 0 }, 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{}, func(i int) bool { return i == 0 }, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FindLastIfSlice(tc.haystack, tc.comparator)
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_FindLastIfIterator(t *testing.T) {
+	tcs := []struct {
+		haystack   []int
+		comparator func(int) bool
+		exp        int
+		err        error
+		name       string
+	}{
+		{[]int{1, 2, 1, 3}, func(i int) bool { return i == 1 }, 2, nil, "Found"},
+		{[]int{1, 2}, func(i int) bool { return i == 0 }, 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{}, func(i int) bool { return i == 0 }, 0, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := goaoi.FindLastIfIterator[int, int](arraylist.NewFromSlice(tc.haystack).Begin(), tc.comparator)
+
+			assert.Equal(t, tc.exp, res)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_ForeachReverseSlice(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		failAt   int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3}, 0, []int{3, 2, 1}, nil, "Visited"},
+		{[]int{1, 2, 3}, 2, []int{3}, goaoi.ExecutionError[int, int]{BadItemIndex: 1, BadItem: 2, Inner: assert.AnError}, "Error"},
+		{[]int{}, 0, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			visited := []int{}
+			err := goaoi.ForeachReverseSlice(tc.haystack, func(i int) error {
+				if i == tc.failAt {
+					return assert.AnError
+				}
+
+				visited = append(visited, i)
+
+				return nil
+			})
+
+			assert.Equal(t, tc.exp, visited)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func Test_ReverseIterator(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      []int
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3}, []int{3, 2, 1}, nil, "Reversed"},
+		{[]int{1}, []int{1}, nil, "Single"},
+		{[]int{}, []int{}, goaoi.EmptyIterableError{}, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it, err := goaoi.ReverseIterator[int, int](arraylist.NewFromSlice(tc.original).Begin())
+
+			assert.Equal(t, tc.exp, arraylist.NewFromIterator[int](it).GetSlice())
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_ReverseIteratorBidirectional(t *testing.T) {
+	taken, _ := goaoi.TakeNIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin(), 3)
+	it, err := goaoi.ReverseIterator(taken.(compounditerators.ReadBidIndexIterator[int, int]))
+	assert.Nil(t, err)
+
+	backward := []int{}
+	for it.Next() {
+		value, _ := it.Get()
+		backward = append(backward, value)
+	}
+
+	forward := []int{}
+	for it.Previous() {
+		value, _ := it.Get()
+		forward = append(forward, value)
+	}
+
+	assert.Equal(t, []int{3, 2, 1}, backward)
+	assert.Equal(t, []int{1, 2, 3}, forward)
+	assert.True(t, it.IsBegin())
+}
+
+func Test_NewReverseSlice(t *testing.T) {
+	it := iteratoradapters.NewReverseSlice([]string{"a", "b", "c"})
+
+	assert.Equal(t, []string{"c", "b", "a"}, arraylist.NewFromIterator[string](it).GetSlice())
+}
+
+func Test_PeekableIterator(t *testing.T) {
+	it, err := goaoi.PeekableIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4, 5, 6, 7}).Begin())
+	assert.Nil(t, err)
+
+	value, found := it.Peek()
+	assert.True(t, found)
+	assert.Equal(t, 1, value)
+	assert.True(t, it.IsBegin())
+
+	value, found = it.PeekN(6)
+	assert.True(t, found)
+	assert.Equal(t, 6, value)
+
+	_, found = it.PeekN(8)
+	assert.False(t, found)
+
+	assert.True(t, it.NextN(2))
+	value, _ = it.Get()
+	assert.Equal(t, 2, value)
+
+	assert.True(t, it.Unread())
+	value, _ = it.Get()
+	assert.Equal(t, 1, value)
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.True(t, it.Unread())
+	assert.False(t, it.Unread())
+
+	value, _ = it.Peek()
+	assert.Equal(t, 3, value)
+
+	res := []int{}
+	for it.Next() {
+		value, _ := it.Get()
+		res = append(res, value)
+	}
+
+	assert.Equal(t, []int{3, 4, 5, 6, 7}, res)
+
+	assert.True(t, it.Unread())
+	value, _ = it.Get()
+	assert.Equal(t, 7, value)
+	assert.True(t, it.IsLast())
+}
+
+func Test_PeekableIteratorEmpty(t *testing.T) {
+	it, err := goaoi.PeekableIterator[int, int](arraylist.New[int]().Begin())
+	assert.ErrorAs(t, err, &goaoi.EmptyIterableError{})
+
+	_, found := it.Peek()
+	assert.False(t, found)
+	assert.True(t, it.IsEnd())
+	assert.False(t, it.Next())
+}
+
+func Test_TakeWhileIteratorPeekable(t *testing.T) {
+	tokens, _ := goaoi.PeekableIterator[int, rune](arraylist.NewFromSlice([]rune("42abc")).Begin())
+
+	digits, _ := goaoi.TakeWhileIterator[int, rune](tokens, unicode.IsDigit)
+	assert.Equal(t, []rune("42"), arraylist.NewFromIterator[rune](digits).GetSlice())
+
+	letters, _ := goaoi.DropWhileIterator[int, rune](tokens, unicode.IsDigit)
+	assert.Equal(t, []rune("abc"), arraylist.NewFromIterator[rune](letters).GetSlice())
+}
+
+func Test_TakeWhileDropWhileNested(t *testing.T) {
+	tcs := []struct {
+		adapt func(ds.ReadForIndexIterator[int, rune]) (ds.ReadForIndexIterator[int, rune], error)
+		exp   string
+		name  string
+	}{
+		{
+			func(it ds.ReadForIndexIterator[int, rune]) (ds.ReadForIndexIterator[int, rune], error) {
+				inner, _ := goaoi.TakeWhileIterator(it, func(r rune) bool { return r < '5' })
+
+				return goaoi.TakeWhileIterator(inner, func(r rune) bool { return r < '9' })
+			},
+			"1234", "TakeWhile of TakeWhile",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, rune]) (ds.ReadForIndexIterator[int, rune], error) {
+				inner, _ := goaoi.TakeWhileIterator(it, func(r rune) bool { return r < '9' })
+
+				return goaoi.TakeWhileIterator(inner, func(r rune) bool { return r < '5' })
+			},
+			"1234", "Stricter outer TakeWhile",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, rune]) (ds.ReadForIndexIterator[int, rune], error) {
+				inner, _ := goaoi.DropWhileIterator(it, func(r rune) bool { return r < '3' })
+
+				return goaoi.DropWhileIterator(inner, func(r rune) bool { return r < '5' })
+			},
+			"56789", "DropWhile of DropWhile",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, rune]) (ds.ReadForIndexIterator[int, rune], error) {
+				inner, _ := goaoi.TakeWhileIterator(it, func(r rune) bool { return r < '7' })
+
+				return goaoi.DropWhileIterator(inner, func(r rune) bool { return r < '3' })
+			},
+			"3456", "DropWhile of TakeWhile",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			it, err := tc.adapt(arraylist.NewFromSlice([]rune("123456789")).Begin())
+			assert.Nil(t, err)
+
+			assert.Equal(t, []rune(tc.exp), arraylist.NewFromIterator[rune](it).GetSlice())
+		})
+	}
+}
+
+func Test_TakeWhileOfDropWhileLetters(t *testing.T) {
+	digitsDropped, _ := goaoi.DropWhileIterator[int, rune](arraylist.NewFromSlice([]rune("42abc1")).Begin(), unicode.IsDigit)
+	letters, _ := goaoi.TakeWhileIterator(digitsDropped, unicode.IsLetter)
+
+	assert.Equal(t, []rune("abc"), arraylist.NewFromIterator[rune](letters).GetSlice())
+}
+
+func Test_IteratorErrorPropagationTransform(t *testing.T) {
+	tcs := []struct {
+		consume func(ds.ReadForIndexIterator[int, int]) (int, error)
+		exp     int
+		name    string
+	}{
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				sum := 0
+				err := goaoi.ForeachIterator(it, func(i int) error { sum += i; return nil })
+
+				return sum, err
+			},
+			1, "Foreach",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				sum := 0
+				err := goaoi.ForeachIteratorUnsafe(it, func(i int) { sum += i })
+
+				return sum, err
+			},
+			1, "ForeachUnsafe",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.CountIfIterator(it, func(i int) bool { return i < 0 })
+			},
+			0, "CountIf",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.AccumulateIterator(it, 0, functional.Add[int])
+			},
+			1, "Accumulate",
+		},
+
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.FoldIterator(it, 0, func(acc int, i int) (int, error) { return acc + i, nil })
+			},
+			1, "Fold",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.ReduceIterator(it, func(acc int, i int) (int, error) { return acc + i, nil })
+			},
+			1, "Reduce",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			transformed, _ := goaoi.TransformIterator[int, int](arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin(), func(i int) (int, error) {
+				if i == 2 {
+					return -100, assert.AnError
+				}
+
+				return i, nil
+			})
+
+			res, err := tc.consume(transformed)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 1, Inner: assert.AnError}, err)
+		})
+	}
+}
+
+// getFailingIterator fails to get the element at index bad, without implementing compounditerators.ErrIterator.
+type getFailingIterator struct {
+	compounditerators.ReadBidIndexIterator[int, int]
+	bad int
+}
+
+func (it *getFailingIterator) Get() (int, bool) {
+	if i, _ := it.Index(); i == it.bad {
+		return 0, false
+	}
+
+	return it.ReadBidIndexIterator.Get()
+}
+
+func Test_IteratorErrorPropagationGet(t *testing.T) {
+	tcs := []struct {
+		consume func(ds.ReadForIndexIterator[int, int]) (int, error)
+		exp     int
+		name    string
+	}{
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				sum := 0
+				err := goaoi.ForeachIterator(it, func(i int) error { sum += i; return nil })
+
+				return sum, err
+			},
+			1, "Foreach",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				sum := 0
+				err := goaoi.ForeachIteratorUnsafe(it, func(i int) { sum += i })
+
+				return sum, err
+			},
+			1, "ForeachUnsafe",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.CountIfIterator(it, func(i int) bool { return i < 0 })
+			},
+			0, "CountIf",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.AccumulateIterator(it, 0, functional.Add[int])
+			},
+			1, "Accumulate",
+		},
+
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.FoldIterator(it, 0, func(acc int, i int) (int, error) { return acc + i, nil })
+			},
+			1, "Fold",
+		},
+		{
+			func(it ds.ReadForIndexIterator[int, int]) (int, error) {
+				return goaoi.ReduceIterator(it, func(acc int, i int) (int, error) { return acc + i, nil })
+			},
+			1, "Reduce",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			failing := &getFailingIterator{arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin(), 1}
+
+			res, err := tc.consume(failing)
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 1, Inner: goaoi.ElementNotFoundError{}}, err)
+		})
+	}
+}
+
+func Test_IteratorErrorPropagationGetConsumers(t *testing.T) {
+	other := func() ds.ReadForIndexIterator[int, int] { return arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin() }
+
+	tcs := []struct {
+		consume func(*getFailingIterator) error
+		name    string
+	}{
+		{
+			func(it *getFailingIterator) error {
+				_, err := goaoi.FindLastIfIterator[int, int](it, func(i int) bool { return false })
+
+				return err
+			},
+			"FindLastIf",
+		},
+		{
+			func(it *getFailingIterator) error {
+				_, err := goaoi.SampleIterator[int, int](it, 10, rand.NewSource(42))
+
+				return err
+			},
+			"Sample",
+		},
+		{
+			func(it *getFailingIterator) error {
+				_, err := goaoi.SearchIteratorPred[int, int](it, []int{9}, functional.AreEqual[int])
+
+				return err
+			},
+			"Search",
+		},
+		{
+			func(it *getFailingIterator) error {
+				_, err := goaoi.LexicographicalCompareIteratorPred[int, int](it, other(), functional.IsLessThan[int])
+
+				return err
+			},
+			"LexicographicalCompare",
+		},
+		{
+			func(it *getFailingIterator) error {
+				return goaoi.EqualIterator[int, int](other(), it)
+			},
+			"Equal",
+		},
+		{
+			func(it *getFailingIterator) error {
+				_, err := goaoi.InnerProductIterator[int, int](it, other(), 0)
+
+				return err
+			},
+			"InnerProduct",
+		},
+		{
+			func(it *getFailingIterator) error {
+				_, err := goaoi.TransformReduceIterator[int, int](it, 0, functional.Add[int], func(i int) int { return i })
+
+				return err
+			},
+			"TransformReduce",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			failing := &getFailingIterator{arraylist.NewFromSlice([]int{1, 2, 3, 4}).Begin(), 1}
+
+			assert.Equal(t, goaoi.ExecutionError[int, int]{BadItemIndex: 1, Inner: goaoi.ElementNotFoundError{}}, tc.consume(failing))
+		})
+	}
+}
+
+func Test_AccumulateIterator(t *testing.T) {
+	tcs := []struct {
+		original []int
+		exp      int
+		expErr   error
+		name     string
+	}{
+		{[]int{1, 2, 3}, 6, nil, "Accumulated"},
+		{[]int{1, -2, 3}, 1, goaoi.ExecutionError[int, int]{BadItemIndex: 1, Inner: assert.AnError}, "Transformer error"},
+		{[]int{}, 0, nil, "Empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			transformed := iteratoradapters.NewTransformIterator[int, int](arraylist.NewFromSlice(tc.original).Begin(), func(i int) (int, error) {
+				if i < 0 {
+					return 0, assert.AnError
+				}
+
+				return i, nil
+			})
+
+			res, err := goaoi.AccumulateIterator[int, int](transformed, 0, functional.Add[int])
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.expErr, err)
+		})
+	}
+}
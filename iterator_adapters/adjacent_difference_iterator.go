@@ -0,0 +1,102 @@
+package iteratoradapters
+
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// AdjacentDifference yields the first element of the inner iterator and then binaryFunc(current, previous) for each following element.
+// Only the previous element is stored, independent of the number of elements.
+type AdjacentDifference[TKey any, TValue any] struct {
+	compounditerators.ReadForIndexIterator[TKey, TValue]
+	binaryFunc func(TValue, TValue) TValue
+	previous   TValue
+	current    TValue
+	index      int
+	done       bool
+}
+
+func NewAdjacentDifference[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], binaryFunc func(TValue, TValue) TValue) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return &AdjacentDifference[TKey, TValue]{
+		ReadForIndexIterator: inner,
+		binaryFunc:           binaryFunc,
+		index:                -1,
+	}
+}
+
+func (it *AdjacentDifference[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *AdjacentDifference[TKey, TValue]) IsEnd() bool {
+	return it.done || it.ReadForIndexIterator.IsEnd()
+}
+
+func (it *AdjacentDifference[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *AdjacentDifference[TKey, TValue]) IsLast() bool {
+	return it.ReadForIndexIterator.IsLast()
+}
+
+func (it *AdjacentDifference[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *AdjacentDifference[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *AdjacentDifference[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if !it.ReadForIndexIterator.Next() {
+		it.done = true
+
+		return false
+	}
+
+	value, _ := it.ReadForIndexIterator.Get()
+
+	if it.IsBegin() {
+		it.current = value
+	} else {
+		it.current = it.binaryFunc(value, it.previous)
+	}
+
+	it.previous = value
+	it.index++
+
+	return true
+}
+
+func (it *AdjacentDifference[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *AdjacentDifference[TKey, TValue]) Size() int {
+	return it.ReadForIndexIterator.Size()
+}
+
+func (it *AdjacentDifference[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *AdjacentDifference[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
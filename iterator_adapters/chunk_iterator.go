@@ -0,0 +1,120 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// Chunk yields consecutive, non-overlapping slices of n elements of the original iterator.
+// The last chunk is shorter if the number of elements is not divisible by n.
+// If reuseBuffer is set, every chunk is written into the same buffer, which is only valid until the next call to Next.
+// Otherwise, each chunk is newly allocated and can be retained by the caller.
+type Chunk[TKey any, TValue any] struct {
+	original    ds.ReadForIndexIterator[TKey, TValue]
+	n           int
+	reuseBuffer bool
+	current     []TValue
+	index       int
+	done        bool
+}
+
+// NewChunk creates a Chunk adapter, a non-positive n results in an empty iterator.
+func NewChunk[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], n int, reuseBuffer bool) ds.ReadForIndexIterator[int, []TValue] {
+	it := &Chunk[TKey, TValue]{
+		original:    original,
+		n:           n,
+		reuseBuffer: reuseBuffer,
+		index:       -1,
+		done:        n <= 0,
+	}
+
+	if reuseBuffer && n > 0 {
+		it.current = make([]TValue, 0, n)
+	}
+
+	return it
+}
+
+func (it *Chunk[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Chunk[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Chunk[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Chunk[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *Chunk[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Chunk[TKey, TValue]) Get() (value []TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Chunk[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.reuseBuffer {
+		it.current = it.current[:0]
+	} else {
+		it.current = make([]TValue, 0, it.n)
+	}
+
+	for len(it.current) < it.n && it.original.Next() {
+		value, _ := it.original.Get()
+		it.current = append(it.current, value)
+	}
+
+	if len(it.current) == 0 {
+		it.done = true
+
+		return false
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *Chunk[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Chunk[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *Chunk[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Chunk[TKey, TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Chunk[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
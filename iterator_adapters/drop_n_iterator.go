@@ -1,6 +1,7 @@
 package iteratoradapters
 
 import (
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
@@ -11,13 +12,27 @@ type DropN[TKey any, TValue any] struct {
 	size    int
 }
 
+// NewDropN returns a DropNBidRand or DropNRand, if inner supports the respective movements.
 func NewDropN[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], n int) compounditerators.ReadForIndexIterator[TKey, TValue] {
-	return &DropN[TKey, TValue]{
+	it := &DropN[TKey, TValue]{
 		ReadForIndexIterator: inner,
 		nToDrop:              n,
 		index:                -1,
 		size:                 0,
 	}
+
+	random, ok := inner.(compounditerators.ReadForRandIndexIterator[TKey, TValue])
+	if !ok {
+		return it
+	}
+
+	randomIt := DropNRand[TKey, TValue]{DropN: it, random: random, n: n}
+
+	if _, ok := inner.(compounditerators.ReadBidRandIndexIterator[TKey, TValue]); ok {
+		return &DropNBidRand[TKey, TValue]{DropNRand: randomIt}
+	}
+
+	return &randomIt
 }
 
 func (it *DropN[TKey, TValue]) IsBegin() bool {
@@ -95,3 +110,104 @@ func (it *DropN[TKey, TValue]) Size() int {
 func (it *DropN[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *DropN[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
+
+// DropNRand is a DropN, which can be moved to and read from arbitrary indices of the remaining range.
+type DropNRand[TKey any, TValue any] struct {
+	*DropN[TKey, TValue]
+	random compounditerators.ReadForRandIndexIterator[TKey, TValue]
+	n      int
+}
+
+func (it *DropNRand[TKey, TValue]) IsLast() bool {
+	return it.index == it.Size()-1
+}
+
+func (it *DropNRand[TKey, TValue]) Size() int {
+	if it.random.Size() < 0 {
+		return -1
+	}
+
+	return utils.Max(it.random.Size()-it.n, 0)
+}
+
+// position returns the index of it, which is Size() past the last element.
+func (it *DropNRand[TKey, TValue]) position() int {
+	if it.IsEnd() {
+		return it.Size()
+	}
+
+	return it.index
+}
+
+func (it *DropNRand[TKey, TValue]) MoveTo(i int) bool {
+	it.index = utils.Max(i, -1)
+	if it.Size() >= 0 {
+		it.index = utils.Min(it.index, it.Size())
+	}
+
+	// The elements before the new position count as dropped.
+	it.nToDrop = 0
+	it.size = 0
+
+	if it.index == -1 {
+		it.random.MoveTo(it.n - 1)
+
+		return false
+	}
+
+	if !it.random.MoveTo(it.n + it.index) {
+		it.size = -1
+
+		return false
+	}
+
+	return true
+}
+
+func (it *DropNRand[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	if i < 0 {
+		return
+	}
+
+	return it.random.GetAt(it.n + i)
+}
+
+func (it *DropNRand[TKey, TValue]) MoveToKey(key TKey) bool {
+	i, found := indexOfKey(it.random, key)
+	if !found || i < it.n {
+		return false
+	}
+
+	return it.MoveTo(i - it.n)
+}
+
+func (it *DropNRand[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	i, found := indexOfKey(it.random, key)
+	if !found {
+		return
+	}
+
+	return it.GetAt(i - it.n)
+}
+
+// DropNBidRand is a DropNRand, which can also be moved backward.
+type DropNBidRand[TKey any, TValue any] struct {
+	DropNRand[TKey, TValue]
+}
+
+func (it *DropNBidRand[TKey, TValue]) Previous() bool {
+	return it.MoveTo(it.position() - 1)
+}
+
+func (it *DropNBidRand[TKey, TValue]) PreviousN(n int) bool {
+	return it.MoveTo(it.position() - n)
+}
+
+func (it *DropNBidRand[TKey, TValue]) MoveBy(n int) bool {
+	return it.MoveTo(it.position() + n)
+}
@@ -4,19 +4,25 @@ import (
 	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
+// DropWhile looks at the leading elements through a Peekable, so the first element not satisfying unaryPredicate is only read once.
 type DropWhile[TKey any, TValue any] struct {
-	compounditerators.ReadForIndexIterator[TKey, TValue]
+	peekable       compounditerators.PeekIterator[TKey, TValue]
 	unaryPredicate func(value TValue) bool
 	index          int
 	size           int
 }
 
 func NewDropWhile[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	peekable, ok := inner.(compounditerators.PeekIterator[TKey, TValue])
+	if !ok {
+		peekable = NewPeekable[TKey, TValue](inner)
+	}
+
 	return &DropWhile[TKey, TValue]{
-		ReadForIndexIterator: inner,
-		unaryPredicate:       unaryPredicate,
-		index:                -1,
-		size:                 0,
+		peekable:       peekable,
+		unaryPredicate: unaryPredicate,
+		index:          -1,
+		size:           0,
 	}
 }
 
@@ -41,26 +47,22 @@ func (it *DropWhile[TKey, TValue]) IsValid() bool {
 }
 
 func (it *DropWhile[TKey, TValue]) Get() (value TValue, found bool) {
-	return it.ReadForIndexIterator.Get()
+	return it.peekable.Get()
 }
 
 func (it *DropWhile[TKey, TValue]) Next() bool {
 	// Dropping
-	for it.IsBegin() && !it.ReadForIndexIterator.IsEnd() {
-		it.ReadForIndexIterator.Next()
-		value, found := it.Get()
-
-		if !it.unaryPredicate(value) {
-			it.index++
-
-			return found
+	for it.IsBegin() {
+		value, found := it.peekable.Peek()
+		if !found || !it.unaryPredicate(value) {
+			break
 		}
+
+		it.peekable.Next()
 	}
 
 	// Taking
-	it.ReadForIndexIterator.Next()
-
-	if it.ReadForIndexIterator.IsEnd() {
+	if !it.peekable.Next() {
 		it.size = -1
 
 		return false
@@ -94,3 +96,12 @@ func (it *DropWhile[TKey, TValue]) Size() int {
 func (it *DropWhile[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+func (it *DropWhile[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.peekable.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *DropWhile[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.peekable)
+}
@@ -0,0 +1,96 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+	"github.com/barweiss/go-tuple"
+)
+
+// Enumerate yields the elements of the original iterator paired with their position, starting at 0.
+type Enumerate[TKey any, TValue any] struct {
+	original ds.ReadForIndexIterator[TKey, TValue]
+	index    int
+	done     bool
+}
+
+func NewEnumerate[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue]) ds.ReadForIndexIterator[TKey, tuple.T2[int, TValue]] {
+	return &Enumerate[TKey, TValue]{
+		original: original,
+		index:    -1,
+	}
+}
+
+func (it *Enumerate[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Enumerate[TKey, TValue]) IsEnd() bool {
+	return it.done || it.original.IsEnd()
+}
+
+func (it *Enumerate[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Enumerate[TKey, TValue]) IsLast() bool {
+	return it.original.IsLast()
+}
+
+func (it *Enumerate[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Enumerate[TKey, TValue]) Get() (value tuple.T2[int, TValue], found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	inner, found := it.original.Get()
+
+	return tuple.New2(it.index, inner), found
+}
+
+func (it *Enumerate[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if !it.original.Next() {
+		it.done = true
+
+		return false
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *Enumerate[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Enumerate[TKey, TValue]) Size() int {
+	return it.original.Size()
+}
+
+func (it *Enumerate[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Enumerate[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.original.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Enumerate[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
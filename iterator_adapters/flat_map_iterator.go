@@ -0,0 +1,148 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// FlatMap yields the elements of the iterators created by transformer for each element of the original iterator in sequence.
+// The keys are those of the created iterators.
+// If transformer returns an error, the iteration stops and the error is returned by Err.
+type FlatMap[TKey any, TValue any, TInnerKey any, TOut any] struct {
+	original    ds.ReadForIndexIterator[TKey, TValue]
+	transformer func(TValue) (ds.ReadForIndexIterator[TInnerKey, TOut], error)
+	current     ds.ReadForIndexIterator[TInnerKey, TOut]
+	err         error
+	index       int
+	done        bool
+}
+
+// NewFlatMap yields the elements of the slices created by transformer for each element of original in sequence.
+// The keys are the indices into the created slices.
+func NewFlatMap[TKey any, TValue any, TOut any](original ds.ReadForIndexIterator[TKey, TValue], transformer func(TValue) ([]TOut, error)) ds.ReadForIndexIterator[int, TOut] {
+	return NewFlatMapIterator(original, func(value TValue) (ds.ReadForIndexIterator[int, TOut], error) {
+		values, err := transformer(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return arraylist.NewFromSlice(values).Begin(), nil
+	})
+}
+
+// NewFlatMapIterator yields the elements of the iterators created by transformer for each element of original in sequence.
+func NewFlatMapIterator[TKey any, TValue any, TInnerKey any, TOut any](original ds.ReadForIndexIterator[TKey, TValue], transformer func(TValue) (ds.ReadForIndexIterator[TInnerKey, TOut], error)) ds.ReadForIndexIterator[TInnerKey, TOut] {
+	return &FlatMap[TKey, TValue, TInnerKey, TOut]{
+		original:    original,
+		transformer: transformer,
+		index:       -1,
+	}
+}
+
+// NewFlatten yields the elements of the iterators yielded by original in sequence.
+// Unlike NewJoin, the number of iterators does not need to be known up front.
+func NewFlatten[TKey any, TInnerKey any, TValue any](original ds.ReadForIndexIterator[TKey, ds.ReadForIndexIterator[TInnerKey, TValue]]) ds.ReadForIndexIterator[TInnerKey, TValue] {
+	return NewFlatMapIterator(original, func(inner ds.ReadForIndexIterator[TInnerKey, TValue]) (ds.ReadForIndexIterator[TInnerKey, TValue], error) {
+		return inner, nil
+	})
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) IsEnd() bool {
+	return it.done
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) IsLast() bool {
+	return false
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) Get() (value TOut, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.Get()
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	for it.current == nil || !it.current.Next() {
+		if !it.original.Next() {
+			it.done = true
+
+			return false
+		}
+
+		value, _ := it.original.Get()
+
+		inner, err := it.transformer(value)
+		if err != nil {
+			it.err = err
+			it.done = true
+
+			return false
+		}
+
+		it.current = inner
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) Size() int {
+	return -1
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) GetKey() (key TInnerKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *FlatMap[TKey, TValue, TInnerKey, TOut]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	if err := compounditerators.ErrOf(it.current); err != nil {
+		return err
+	}
+
+	return compounditerators.ErrOf(it.original)
+}
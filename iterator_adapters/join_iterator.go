@@ -2,8 +2,20 @@ package iteratoradapters
 
 import (
 	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
+// firstErr returns the first error of the iterators implementing compounditerators.ErrIterator.
+func firstErr[TIterator any](iterators ...TIterator) error {
+	for _, iterator := range iterators {
+		if err := compounditerators.ErrOf(iterator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type Join[TKey any, TValue any] struct {
 	originals     []ds.ReadForIndexIterator[TKey, TValue]
 	index         int
@@ -59,6 +71,13 @@ func (it *Join[TKey, TValue]) Next() bool {
 	it.originals[it.iOriginals].Next()
 
 	for !it.IsEnd() && it.originals[it.iOriginals].IsEnd() {
+		// A failed original ends the whole iteration instead of skipping to the next one.
+		if compounditerators.ErrOf(it.originals[it.iOriginals]) != nil {
+			it.iOriginals = it.sizeOriginals
+
+			return false
+		}
+
 		it.iOriginals++
 		if !it.IsEnd() {
 			it.originals[it.iOriginals].Next()
@@ -95,3 +114,8 @@ func (it *Join[TKey, TValue]) Index() (int, bool) {
 func (it *Join[TKey, TValue]) GetKey() (TKey, bool) {
 	return it.originals[0].GetKey()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Join[TKey, TValue]) Err() error {
+	return firstErr(it.originals...)
+}
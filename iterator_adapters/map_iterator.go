@@ -0,0 +1,112 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// Map yields transformer(element) for each element of the original iterator, which can have a different type than the element.
+// Unlike Transform, transformer is applied once per element when advancing, not on every call to Get.
+// If transformer returns an error, the iteration stops and the error is returned by Err.
+type Map[TKey any, TIn any, TOut any] struct {
+	original    ds.ReadForIndexIterator[TKey, TIn]
+	transformer func(TIn) (TOut, error)
+	current     TOut
+	err         error
+	index       int
+	done        bool
+}
+
+func NewMap[TKey any, TIn any, TOut any](original ds.ReadForIndexIterator[TKey, TIn], transformer func(TIn) (TOut, error)) ds.ReadForIndexIterator[TKey, TOut] {
+	return &Map[TKey, TIn, TOut]{
+		original:    original,
+		transformer: transformer,
+		index:       -1,
+	}
+}
+
+func (it *Map[TKey, TIn, TOut]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Map[TKey, TIn, TOut]) IsEnd() bool {
+	return it.done || it.original.IsEnd()
+}
+
+func (it *Map[TKey, TIn, TOut]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Map[TKey, TIn, TOut]) IsLast() bool {
+	return it.original.IsLast()
+}
+
+func (it *Map[TKey, TIn, TOut]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Map[TKey, TIn, TOut]) Get() (value TOut, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Map[TKey, TIn, TOut]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if !it.original.Next() {
+		it.done = true
+
+		return false
+	}
+
+	value, _ := it.original.Get()
+
+	it.current, it.err = it.transformer(value)
+	if it.err != nil {
+		it.done = true
+
+		return false
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *Map[TKey, TIn, TOut]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Map[TKey, TIn, TOut]) Size() int {
+	return it.original.Size()
+}
+
+func (it *Map[TKey, TIn, TOut]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Map[TKey, TIn, TOut]) GetKey() (TKey, bool) {
+	return it.original.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Map[TKey, TIn, TOut]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	return compounditerators.ErrOf(it.original)
+}
@@ -0,0 +1,174 @@
+package iteratoradapters
+
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+type mergeEntry[TKey any, TValue any] struct {
+	value  TValue
+	key    TKey
+	source int
+}
+
+// Merge lazily merges multiple sorted iterators into one sorted iterator.
+// The current elements of all originals are kept in a binary heap, so yielding an element takes O(log(n)) comparisons for n originals.
+// Equivalent elements are yielded in the order of the originals they stem from.
+type Merge[TKey any, TValue any] struct {
+	originals       []compounditerators.ReadForIndexIterator[TKey, TValue]
+	binaryPredicate func(TValue, TValue) bool
+	heap            []mergeEntry[TKey, TValue]
+	current         mergeEntry[TKey, TValue]
+	index           int
+	done            bool
+}
+
+func NewMerge[TKey any, TValue any](binaryPredicate func(TValue, TValue) bool, originals ...compounditerators.ReadForIndexIterator[TKey, TValue]) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return &Merge[TKey, TValue]{
+		originals:       originals,
+		binaryPredicate: binaryPredicate,
+		heap:            make([]mergeEntry[TKey, TValue], 0, len(originals)),
+		index:           -1,
+	}
+}
+
+func (it *Merge[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Merge[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Merge[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Merge[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *Merge[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Merge[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.value, true
+}
+
+// less orders entries by value and by the position of their original to keep the merge stable.
+func (it *Merge[TKey, TValue]) less(i int, j int) bool {
+	if it.binaryPredicate(it.heap[i].value, it.heap[j].value) {
+		return true
+	}
+	if it.binaryPredicate(it.heap[j].value, it.heap[i].value) {
+		return false
+	}
+
+	return it.heap[i].source < it.heap[j].source
+}
+
+func (it *Merge[TKey, TValue]) push(source int) {
+	if !it.originals[source].Next() {
+		return
+	}
+
+	value, _ := it.originals[source].Get()
+	key, _ := it.originals[source].GetKey()
+	it.heap = append(it.heap, mergeEntry[TKey, TValue]{value: value, key: key, source: source})
+
+	for i := len(it.heap) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !it.less(i, parent) {
+			break
+		}
+
+		it.heap[i], it.heap[parent] = it.heap[parent], it.heap[i]
+		i = parent
+	}
+}
+
+func (it *Merge[TKey, TValue]) pop() mergeEntry[TKey, TValue] {
+	top := it.heap[0]
+	last := len(it.heap) - 1
+
+	it.heap[0] = it.heap[last]
+	it.heap = it.heap[:last]
+
+	for i := 0; ; {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < len(it.heap) && it.less(left, smallest) {
+			smallest = left
+		}
+		if right < len(it.heap) && it.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+
+		it.heap[i], it.heap[smallest] = it.heap[smallest], it.heap[i]
+		i = smallest
+	}
+
+	return top
+}
+
+func (it *Merge[TKey, TValue]) Next() bool {
+	if it.IsEnd() {
+		return false
+	}
+
+	if it.IsBegin() {
+		for i := range it.originals {
+			it.push(i)
+		}
+	} else {
+		it.push(it.current.source)
+	}
+
+	if len(it.heap) == 0 || it.Err() != nil {
+		it.done = true
+
+		return false
+	}
+
+	it.current = it.pop()
+	it.index++
+
+	return true
+}
+
+func (it *Merge[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Merge[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *Merge[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Merge[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.current.key, it.IsValid()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Merge[TKey, TValue]) Err() error {
+	return firstErr(it.originals...)
+}
@@ -0,0 +1,107 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+	"github.com/barweiss/go-tuple"
+)
+
+// Pairwise yields each pair of adjacent elements of the original iterator, so that n elements result in n-1 pairs.
+type Pairwise[TKey any, TValue any] struct {
+	original ds.ReadForIndexIterator[TKey, TValue]
+	current  tuple.T2[TValue, TValue]
+	index    int
+	done     bool
+}
+
+func NewPairwise[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue]) ds.ReadForIndexIterator[int, tuple.T2[TValue, TValue]] {
+	return &Pairwise[TKey, TValue]{
+		original: original,
+		index:    -1,
+	}
+}
+
+func (it *Pairwise[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Pairwise[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Pairwise[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Pairwise[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *Pairwise[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Pairwise[TKey, TValue]) Get() (value tuple.T2[TValue, TValue], found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Pairwise[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.IsBegin() {
+		if !it.original.Next() {
+			it.done = true
+
+			return false
+		}
+
+		it.current.V2, _ = it.original.Get()
+	}
+
+	if !it.original.Next() {
+		it.done = true
+
+		return false
+	}
+
+	it.current.V1 = it.current.V2
+	it.current.V2, _ = it.original.Get()
+	it.index++
+
+	return true
+}
+
+func (it *Pairwise[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Pairwise[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *Pairwise[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Pairwise[TKey, TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Pairwise[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
@@ -0,0 +1,198 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// peekableBufferSize is the initial capacity of the lookahead buffer of a Peekable.
+const peekableBufferSize = 4
+
+// peeked is an element, which has already been read from the original iterator of a Peekable.
+type peeked[TKey any, TValue any] struct {
+	key   TKey
+	value TValue
+}
+
+// Peekable yields the elements of the original iterator and allows looking at upcoming elements without moving.
+// Elements read ahead are kept in a ring buffer, which grows if more elements are peeked at once.
+// Only a single position can be unread between two calls to Next.
+type Peekable[TKey any, TValue any] struct {
+	original    ds.ReadForIndexIterator[TKey, TValue]
+	buffer      []peeked[TKey, TValue]
+	head        int
+	count       int
+	current     peeked[TKey, TValue]
+	previous    peeked[TKey, TValue]
+	hasPrevious bool
+	index       int
+	done        bool
+}
+
+func NewPeekable[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue]) compounditerators.PeekIterator[TKey, TValue] {
+	return &Peekable[TKey, TValue]{
+		original: original,
+		buffer:   make([]peeked[TKey, TValue], peekableBufferSize),
+		index:    -1,
+	}
+}
+
+func (it *Peekable[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Peekable[TKey, TValue]) IsEnd() bool {
+	return it.done || (it.IsBegin() && it.count == 0 && it.original.IsEnd())
+}
+
+func (it *Peekable[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Peekable[TKey, TValue]) IsLast() bool {
+	_, found := it.Peek()
+
+	return it.IsValid() && !found
+}
+
+func (it *Peekable[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Peekable[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.value, true
+}
+
+func (it *Peekable[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.count == 0 && !it.readAhead() {
+		it.done = true
+
+		return false
+	}
+
+	it.previous, it.hasPrevious = it.current, it.IsValid()
+	it.current = it.buffer[it.head]
+	it.head = (it.head + 1) % len(it.buffer)
+	it.count--
+	it.index++
+
+	return true
+}
+
+func (it *Peekable[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Peekable[TKey, TValue]) Peek() (value TValue, found bool) {
+	return it.PeekN(1)
+}
+
+func (it *Peekable[TKey, TValue]) PeekN(n int) (value TValue, found bool) {
+	if n == 0 {
+		return it.Get()
+	}
+
+	if n < 0 || it.done {
+		return
+	}
+
+	for it.count < n {
+		if !it.readAhead() {
+			return
+		}
+	}
+
+	return it.buffer[(it.head+n-1)%len(it.buffer)].value, true
+}
+
+func (it *Peekable[TKey, TValue]) Unread() bool {
+	if it.done {
+		it.done = false
+
+		return it.IsValid()
+	}
+
+	if it.IsBegin() || (!it.IsFirst() && !it.hasPrevious) {
+		return false
+	}
+
+	it.grow()
+	it.head = (it.head - 1 + len(it.buffer)) % len(it.buffer)
+	it.buffer[it.head] = it.current
+	it.count++
+
+	it.current, it.hasPrevious = it.previous, false
+	it.index--
+
+	return it.IsValid()
+}
+
+func (it *Peekable[TKey, TValue]) Size() int {
+	return it.original.Size()
+}
+
+func (it *Peekable[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Peekable[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.key, true
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Peekable[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
+
+// readAhead appends the next element of the original iterator to the buffer.
+func (it *Peekable[TKey, TValue]) readAhead() bool {
+	if !it.original.Next() {
+		return false
+	}
+
+	var element peeked[TKey, TValue]
+	element.value, _ = it.original.Get()
+	element.key, _ = it.original.GetKey()
+
+	it.grow()
+	it.buffer[(it.head+it.count)%len(it.buffer)] = element
+	it.count++
+
+	return true
+}
+
+// grow doubles the capacity of the buffer, if it is full.
+func (it *Peekable[TKey, TValue]) grow() {
+	if it.count < len(it.buffer) {
+		return
+	}
+
+	buffer := make([]peeked[TKey, TValue], utils.Max(2*len(it.buffer), peekableBufferSize))
+	for i := 0; i < it.count; i++ {
+		buffer[i] = it.buffer[(it.head+i)%len(it.buffer)]
+	}
+
+	it.buffer = buffer
+	it.head = 0
+}
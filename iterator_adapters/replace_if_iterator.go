@@ -90,3 +90,8 @@ func (it *TakeIf[TKey, TValue]) Size() int {
 func (it *TakeIf[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *TakeIf[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
@@ -0,0 +1,140 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// Reverse yields the elements of the original iterator from the last to the first one.
+// Before the first element is yielded, the original iterator is advanced to its end.
+// Moving the Reverse backward moves the original iterator forward.
+type Reverse[TKey any, TValue any] struct {
+	original compounditerators.ReadBidIndexIterator[TKey, TValue]
+	index    int
+	done     bool
+}
+
+func NewReverse[TKey any, TValue any](original compounditerators.ReadBidIndexIterator[TKey, TValue]) compounditerators.ReadBidIndexIterator[TKey, TValue] {
+	return &Reverse[TKey, TValue]{
+		original: original,
+		index:    -1,
+	}
+}
+
+// NewReverseSlice yields the elements of slice from the last to the first one.
+func NewReverseSlice[T any](slice []T) compounditerators.ReadBidIndexIterator[int, T] {
+	return NewReverse[int, T](arraylist.NewFromSlice(slice).Begin())
+}
+
+func (it *Reverse[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Reverse[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Reverse[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Reverse[TKey, TValue]) IsLast() bool {
+	return it.IsValid() && it.original.IsFirst()
+}
+
+func (it *Reverse[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Reverse[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.original.Get()
+}
+
+func (it *Reverse[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.IsBegin() {
+		for it.original.Next() {
+		}
+	}
+
+	it.index++
+
+	if !it.original.Previous() {
+		it.done = true
+
+		return false
+	}
+
+	return true
+}
+
+func (it *Reverse[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Reverse[TKey, TValue]) Previous() bool {
+	if it.IsBegin() {
+		return false
+	}
+
+	it.index--
+	it.done = false
+
+	found := it.original.Next()
+
+	return found && it.IsValid()
+}
+
+func (it *Reverse[TKey, TValue]) PreviousN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Previous()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Reverse[TKey, TValue]) MoveBy(n int) bool {
+	if n > 0 {
+		return it.NextN(n)
+	} else if n < 0 {
+		return it.PreviousN(-n)
+	}
+
+	return it.IsValid()
+}
+
+func (it *Reverse[TKey, TValue]) Size() int {
+	return it.original.Size()
+}
+
+func (it *Reverse[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Reverse[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.original.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Reverse[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
@@ -0,0 +1,123 @@
+package iteratoradapters
+
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// Scan yields the running accumulation of the elements of the inner iterator with binaryFunc.
+// An inclusive scan includes the current element in the accumulation, an exclusive scan yields the accumulation before it.
+// Only the current accumulation is stored, independent of the number of elements.
+type Scan[TKey any, TValue any] struct {
+	compounditerators.ReadForIndexIterator[TKey, TValue]
+	binaryFunc  func(TValue, TValue) TValue
+	accumulator TValue
+	current     TValue
+	inclusive   bool
+	index       int
+	done        bool
+}
+
+// NewInclusiveScan yields x0, binaryFunc(x0, x1), binaryFunc(binaryFunc(x0, x1), x2), ...
+func NewInclusiveScan[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], binaryFunc func(TValue, TValue) TValue) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return &Scan[TKey, TValue]{
+		ReadForIndexIterator: inner,
+		binaryFunc:           binaryFunc,
+		inclusive:            true,
+		index:                -1,
+	}
+}
+
+// NewExclusiveScan yields initial, binaryFunc(initial, x0), binaryFunc(binaryFunc(initial, x0), x1), ...
+// The last element of the inner iterator is therefore not part of any yielded accumulation.
+func NewExclusiveScan[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], initial TValue, binaryFunc func(TValue, TValue) TValue) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return &Scan[TKey, TValue]{
+		ReadForIndexIterator: inner,
+		binaryFunc:           binaryFunc,
+		accumulator:          initial,
+		index:                -1,
+	}
+}
+
+func (it *Scan[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Scan[TKey, TValue]) IsEnd() bool {
+	return it.done || it.ReadForIndexIterator.IsEnd()
+}
+
+func (it *Scan[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Scan[TKey, TValue]) IsLast() bool {
+	return it.ReadForIndexIterator.IsLast()
+}
+
+func (it *Scan[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Scan[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Scan[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if !it.ReadForIndexIterator.Next() {
+		it.done = true
+
+		return false
+	}
+
+	value, _ := it.ReadForIndexIterator.Get()
+
+	if it.inclusive {
+		if it.IsBegin() {
+			it.accumulator = value
+		} else {
+			it.accumulator = it.binaryFunc(it.accumulator, value)
+		}
+
+		it.current = it.accumulator
+	} else {
+		it.current = it.accumulator
+		it.accumulator = it.binaryFunc(it.accumulator, value)
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *Scan[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Scan[TKey, TValue]) Size() int {
+	return it.ReadForIndexIterator.Size()
+}
+
+func (it *Scan[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Scan[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
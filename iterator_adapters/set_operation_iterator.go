@@ -0,0 +1,222 @@
+package iteratoradapters
+
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+type setOperationKind int
+
+const (
+	setUnion setOperationKind = iota
+	setIntersection
+	setDifference
+	setSymmetricDifference
+)
+
+// SetOperation lazily combines two sorted iterators like the corresponding STL set operations.
+// Elements are consumed from the inner iterators while iterating, nothing is materialized.
+type SetOperation[TKey any, TValue any] struct {
+	first           compounditerators.ReadForIndexIterator[TKey, TValue]
+	second          compounditerators.ReadForIndexIterator[TKey, TValue]
+	binaryPredicate func(TValue, TValue) bool
+	kind            setOperationKind
+	firstValue      TValue
+	secondValue     TValue
+	firstValid      bool
+	secondValid     bool
+	value           TValue
+	key             TKey
+	index           int
+	done            bool
+}
+
+func newSetOperation[TKey any, TValue any](first compounditerators.ReadForIndexIterator[TKey, TValue], second compounditerators.ReadForIndexIterator[TKey, TValue], binaryPredicate func(TValue, TValue) bool, kind setOperationKind) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return &SetOperation[TKey, TValue]{
+		first:           first,
+		second:          second,
+		binaryPredicate: binaryPredicate,
+		kind:            kind,
+		index:           -1,
+	}
+}
+
+// NewSetUnion yields every element contained in first or second.
+// Equivalent elements contained in both are yielded max(n_first, n_second) times.
+func NewSetUnion[TKey any, TValue any](first compounditerators.ReadForIndexIterator[TKey, TValue], second compounditerators.ReadForIndexIterator[TKey, TValue], binaryPredicate func(TValue, TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return newSetOperation(first, second, binaryPredicate, setUnion)
+}
+
+// NewSetIntersection yields every element contained in first and second.
+// Equivalent elements are yielded min(n_first, n_second) times.
+func NewSetIntersection[TKey any, TValue any](first compounditerators.ReadForIndexIterator[TKey, TValue], second compounditerators.ReadForIndexIterator[TKey, TValue], binaryPredicate func(TValue, TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return newSetOperation(first, second, binaryPredicate, setIntersection)
+}
+
+// NewSetDifference yields every element contained in first but not in second.
+// Equivalent elements are yielded max(n_first - n_second, 0) times.
+func NewSetDifference[TKey any, TValue any](first compounditerators.ReadForIndexIterator[TKey, TValue], second compounditerators.ReadForIndexIterator[TKey, TValue], binaryPredicate func(TValue, TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return newSetOperation(first, second, binaryPredicate, setDifference)
+}
+
+// NewSetSymmetricDifference yields every element contained in either first or second, but not in both.
+// Equivalent elements are yielded |n_first - n_second| times.
+func NewSetSymmetricDifference[TKey any, TValue any](first compounditerators.ReadForIndexIterator[TKey, TValue], second compounditerators.ReadForIndexIterator[TKey, TValue], binaryPredicate func(TValue, TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return newSetOperation(first, second, binaryPredicate, setSymmetricDifference)
+}
+
+func (it *SetOperation[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *SetOperation[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *SetOperation[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *SetOperation[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *SetOperation[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *SetOperation[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.value, true
+}
+
+func (it *SetOperation[TKey, TValue]) advanceFirst() {
+	it.firstValid = it.first.Next()
+	if it.firstValid {
+		it.firstValue, _ = it.first.Get()
+	}
+}
+
+func (it *SetOperation[TKey, TValue]) advanceSecond() {
+	it.secondValid = it.second.Next()
+	if it.secondValid {
+		it.secondValue, _ = it.second.Get()
+	}
+}
+
+func (it *SetOperation[TKey, TValue]) yieldFirst() bool {
+	it.value = it.firstValue
+	it.key, _ = it.first.GetKey()
+	it.advanceFirst()
+
+	return true
+}
+
+func (it *SetOperation[TKey, TValue]) yieldSecond() bool {
+	it.value = it.secondValue
+	it.key, _ = it.second.GetKey()
+	it.advanceSecond()
+
+	return true
+}
+
+// step computes the next element of the result and reports if one was found.
+func (it *SetOperation[TKey, TValue]) step() bool {
+	for it.firstValid || it.secondValid {
+		switch {
+		case !it.secondValid:
+			if it.kind == setIntersection {
+				return false
+			}
+
+			return it.yieldFirst()
+		case !it.firstValid:
+			if it.kind == setIntersection || it.kind == setDifference {
+				return false
+			}
+
+			return it.yieldSecond()
+		case it.binaryPredicate(it.firstValue, it.secondValue):
+			if it.kind == setIntersection {
+				it.advanceFirst()
+
+				continue
+			}
+
+			return it.yieldFirst()
+		case it.binaryPredicate(it.secondValue, it.firstValue):
+			if it.kind == setUnion || it.kind == setSymmetricDifference {
+				return it.yieldSecond()
+			}
+
+			it.advanceSecond()
+		default:
+			if it.kind == setUnion || it.kind == setIntersection {
+				it.advanceSecond()
+
+				return it.yieldFirst()
+			}
+
+			it.advanceFirst()
+			it.advanceSecond()
+		}
+	}
+
+	return false
+}
+
+func (it *SetOperation[TKey, TValue]) Next() bool {
+	if it.IsEnd() {
+		return false
+	}
+
+	if it.IsBegin() {
+		it.advanceFirst()
+		it.advanceSecond()
+	}
+
+	if !it.step() {
+		it.done = true
+
+		return false
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *SetOperation[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *SetOperation[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *SetOperation[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *SetOperation[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.key, it.IsValid()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *SetOperation[TKey, TValue]) Err() error {
+	if err := compounditerators.ErrOf(it.first); err != nil {
+		return err
+	}
+
+	return compounditerators.ErrOf(it.second)
+}
@@ -0,0 +1,213 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+type splitNEntry[TKey any, TValue any] struct {
+	key   TKey
+	value TValue
+}
+
+// splitNState is shared by the parts created by NewSplitN.
+// Elements pulled from the original iterator on behalf of a later part, which belong to an earlier one, are buffered for it,
+// so consuming the parts in order, as JoinIterator does, needs no buffering at all.
+type splitNState[TKey any, TValue any] struct {
+	original ds.ReadForIndexIterator[TKey, TValue]
+	// Exclusive end position of each part
+	bounds  []int
+	pulled  int
+	buffers [][]splitNEntry[TKey, TValue]
+}
+
+func (state *splitNState[TKey, TValue]) pull() splitNEntry[TKey, TValue] {
+	state.original.Next()
+	state.pulled++
+
+	value, _ := state.original.Get()
+	key, _ := state.original.GetKey()
+
+	return splitNEntry[TKey, TValue]{key: key, value: value}
+}
+
+func (state *splitNState[TKey, TValue]) partOf(position int) int {
+	k := 0
+	for state.bounds[k] <= position {
+		k++
+	}
+
+	return k
+}
+
+// SplitNPart is one of the parts created by NewSplitN.
+type SplitNPart[TKey any, TValue any] struct {
+	state    *splitNState[TKey, TValue]
+	part     int
+	position int
+	current  splitNEntry[TKey, TValue]
+	index    int
+	done     bool
+}
+
+// NewSplitN splits original into m lazy parts of roughly equal size, the first len % m parts containing one more element.
+// Only if original is a random access iterator, its size is known without consuming it.
+// Otherwise, all remaining elements are buffered up front to count them.
+// A non-positive m results in no parts.
+func NewSplitN[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], m int) []ds.ReadForIndexIterator[TKey, TValue] {
+	if m <= 0 {
+		return []ds.ReadForIndexIterator[TKey, TValue]{}
+	}
+
+	state := &splitNState[TKey, TValue]{
+		original: original,
+		bounds:   make([]int, m),
+		buffers:  make([][]splitNEntry[TKey, TValue], m),
+	}
+
+	var n int
+	var entries []splitNEntry[TKey, TValue]
+
+	if _, ok := original.(ds.RandomAccessReadableIterator[TKey, TValue]); ok {
+		n = original.Size()
+		if i, ok := original.Index(); ok {
+			n -= i + 1
+		}
+	} else {
+		for !original.IsEnd() && original.Next() {
+			value, _ := original.Get()
+			key, _ := original.GetKey()
+
+			entries = append(entries, splitNEntry[TKey, TValue]{key: key, value: value})
+		}
+
+		n = len(entries)
+		state.pulled = n
+	}
+
+	end := 0
+	for k := range state.bounds {
+		end += n / m
+		if k < n%m {
+			end++
+		}
+
+		state.bounds[k] = end
+	}
+
+	for position, entry := range entries {
+		k := state.partOf(position)
+		state.buffers[k] = append(state.buffers[k], entry)
+	}
+
+	parts := make([]ds.ReadForIndexIterator[TKey, TValue], m)
+	for k := range parts {
+		part := &SplitNPart[TKey, TValue]{
+			state: state,
+			part:  k,
+			index: -1,
+		}
+
+		if k > 0 {
+			part.position = state.bounds[k-1]
+		}
+
+		part.done = part.position == state.bounds[k]
+
+		parts[k] = part
+	}
+
+	return parts
+}
+
+func (it *SplitNPart[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *SplitNPart[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *SplitNPart[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *SplitNPart[TKey, TValue]) IsLast() bool {
+	return it.position == it.state.bounds[it.part]
+}
+
+func (it *SplitNPart[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *SplitNPart[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.value, true
+}
+
+func (it *SplitNPart[TKey, TValue]) Next() bool {
+	if it.done || it.position == it.state.bounds[it.part] {
+		it.done = true
+
+		return false
+	}
+
+	if it.position < it.state.pulled {
+		it.current = it.state.buffers[it.part][0]
+		it.state.buffers[it.part] = it.state.buffers[it.part][1:]
+	} else {
+		for it.state.pulled < it.position {
+			position := it.state.pulled
+			k := it.state.partOf(position)
+
+			it.state.buffers[k] = append(it.state.buffers[k], it.state.pull())
+		}
+
+		it.current = it.state.pull()
+	}
+
+	it.position++
+	it.index++
+
+	return true
+}
+
+func (it *SplitNPart[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *SplitNPart[TKey, TValue]) Size() int {
+	if it.part == 0 {
+		return it.state.bounds[0]
+	}
+
+	return it.state.bounds[it.part] - it.state.bounds[it.part-1]
+}
+
+func (it *SplitNPart[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *SplitNPart[TKey, TValue]) GetKey() (key TKey, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current.key, true
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *SplitNPart[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.state.original)
+}
@@ -0,0 +1,196 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// SplitWhen yields lazy parts of the original iterator, separated by the elements satisfying unaryPredicate.
+// The separators are not part of any part, so n separators result in n+1, possibly empty, parts.
+// Advancing to the next part skips the remaining elements of the current one.
+type SplitWhen[TKey any, TValue any] struct {
+	original       ds.ReadForIndexIterator[TKey, TValue]
+	unaryPredicate func(TValue) bool
+	current        *SplitWhenPart[TKey, TValue]
+	// Set when the original iterator ended, instead of a separator ending a part
+	originalDone bool
+	index        int
+	done         bool
+}
+
+// SplitWhenPart is one of the parts yielded by SplitWhen.
+type SplitWhenPart[TKey any, TValue any] struct {
+	parent  *SplitWhen[TKey, TValue]
+	current TValue
+	index   int
+	done    bool
+}
+
+func NewSplitWhen[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool) ds.ReadForIndexIterator[int, ds.ReadForIndexIterator[TKey, TValue]] {
+	return &SplitWhen[TKey, TValue]{
+		original:       original,
+		unaryPredicate: unaryPredicate,
+		index:          -1,
+	}
+}
+
+func (it *SplitWhen[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *SplitWhen[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *SplitWhen[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *SplitWhen[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *SplitWhen[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *SplitWhen[TKey, TValue]) Get() (value ds.ReadForIndexIterator[TKey, TValue], found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *SplitWhen[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.current != nil {
+		for it.current.Next() {
+		}
+	}
+
+	if it.originalDone {
+		it.done = true
+
+		return false
+	}
+
+	it.current = &SplitWhenPart[TKey, TValue]{parent: it, index: -1}
+	it.index++
+
+	return true
+}
+
+func (it *SplitWhen[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *SplitWhen[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *SplitWhen[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *SplitWhen[TKey, TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *SplitWhen[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
+
+func (it *SplitWhenPart[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *SplitWhenPart[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *SplitWhenPart[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *SplitWhenPart[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *SplitWhenPart[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *SplitWhenPart[TKey, TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *SplitWhenPart[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if !it.parent.original.Next() {
+		it.parent.originalDone = true
+		it.done = true
+
+		return false
+	}
+
+	value, _ := it.parent.original.Get()
+	if it.parent.unaryPredicate(value) {
+		it.done = true
+
+		return false
+	}
+
+	it.current = value
+	it.index++
+
+	return true
+}
+
+func (it *SplitWhenPart[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *SplitWhenPart[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *SplitWhenPart[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *SplitWhenPart[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.parent.original.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *SplitWhenPart[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.parent.original)
+}
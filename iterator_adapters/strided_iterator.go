@@ -1,6 +1,7 @@
 package iteratoradapters
 
 import (
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
@@ -22,9 +23,22 @@ func NewStrided[TKey any, TValue any](inner compounditerators.ReadForIndexIterat
 
 	if n == 0 {
 		it.size = -1
+
+		return it
+	}
+
+	random, ok := inner.(compounditerators.ReadForRandIndexIterator[TKey, TValue])
+	if !ok || n < 0 {
+		return it
+	}
+
+	randomIt := StridedRand[TKey, TValue]{Strided: it, random: random}
+
+	if _, ok := inner.(compounditerators.ReadBidRandIndexIterator[TKey, TValue]); ok {
+		return &StridedBidRand[TKey, TValue]{StridedRand: randomIt}
 	}
 
-	return it
+	return &randomIt
 }
 
 func (it *Strided[TKey, TValue]) IsBegin() bool {
@@ -99,3 +113,101 @@ func (it *Strided[TKey, TValue]) Size() int {
 func (it *Strided[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Strided[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
+
+// StridedRand is a Strided, which can be moved to and read from arbitrary indices of the strided range.
+type StridedRand[TKey any, TValue any] struct {
+	*Strided[TKey, TValue]
+	random compounditerators.ReadForRandIndexIterator[TKey, TValue]
+}
+
+func (it *StridedRand[TKey, TValue]) IsLast() bool {
+	return it.index == it.Size()-1
+}
+
+func (it *StridedRand[TKey, TValue]) Size() int {
+	if it.random.Size() < 0 {
+		return -1
+	}
+
+	return (it.random.Size() + it.stride - 1) / it.stride
+}
+
+// position returns the index of it, which is Size() past the last element.
+func (it *StridedRand[TKey, TValue]) position() int {
+	if it.IsEnd() {
+		return it.Size()
+	}
+
+	return it.index
+}
+
+func (it *StridedRand[TKey, TValue]) MoveTo(i int) bool {
+	it.index = utils.Max(i, -1)
+	if it.Size() >= 0 {
+		it.index = utils.Min(it.index, it.Size())
+	}
+
+	it.size = 0
+
+	if it.index == -1 {
+		it.random.MoveTo(-1)
+
+		return false
+	}
+
+	if !it.random.MoveTo(it.index * it.stride) {
+		it.size = -1
+
+		return false
+	}
+
+	return true
+}
+
+func (it *StridedRand[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	if i < 0 {
+		return
+	}
+
+	return it.random.GetAt(i * it.stride)
+}
+
+func (it *StridedRand[TKey, TValue]) MoveToKey(key TKey) bool {
+	i, found := indexOfKey(it.random, key)
+	if !found || i%it.stride != 0 {
+		return false
+	}
+
+	return it.MoveTo(i / it.stride)
+}
+
+func (it *StridedRand[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	i, found := indexOfKey(it.random, key)
+	if !found || i%it.stride != 0 {
+		return
+	}
+
+	return it.GetAt(i / it.stride)
+}
+
+// StridedBidRand is a StridedRand, which can also be moved backward.
+type StridedBidRand[TKey any, TValue any] struct {
+	StridedRand[TKey, TValue]
+}
+
+func (it *StridedBidRand[TKey, TValue]) Previous() bool {
+	return it.MoveTo(it.position() - 1)
+}
+
+func (it *StridedBidRand[TKey, TValue]) PreviousN(n int) bool {
+	return it.MoveTo(it.position() - n)
+}
+
+func (it *StridedBidRand[TKey, TValue]) MoveBy(n int) bool {
+	return it.MoveTo(it.position() + n)
+}
@@ -1,9 +1,11 @@
 package iteratoradapters
 
 import (
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
+// ReplaceIf yields replacement instead of the elements of inner satisfying unaryPredicate.
 type ReplaceIf[TKey any, TValue any] struct {
 	compounditerators.ReadForIndexIterator[TKey, TValue]
 	unaryPredicate func(value TValue) bool
@@ -12,13 +14,28 @@ type ReplaceIf[TKey any, TValue any] struct {
 	replacement    TValue
 }
 
+// NewReplaceIf returns a ReplaceIfBidRand or ReplaceIfRand, if inner supports the respective movements.
 func NewReplaceIf[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool, replacement TValue) compounditerators.ReadForIndexIterator[TKey, TValue] {
-	return &ReplaceIf[TKey, TValue]{
+	it := &ReplaceIf[TKey, TValue]{
 		ReadForIndexIterator: inner,
 		unaryPredicate:       unaryPredicate,
 		index:                -1,
 		size:                 0,
+		replacement:          replacement,
 	}
+
+	random, ok := inner.(compounditerators.ReadForRandIndexIterator[TKey, TValue])
+	if !ok {
+		return it
+	}
+
+	randomIt := ReplaceIfRand[TKey, TValue]{ReplaceIf: it, random: random}
+
+	if _, ok := inner.(compounditerators.ReadBidRandIndexIterator[TKey, TValue]); ok {
+		return &ReplaceIfBidRand[TKey, TValue]{ReplaceIfRand: randomIt}
+	}
+
+	return &randomIt
 }
 
 func (it *ReplaceIf[TKey, TValue]) IsBegin() bool {
@@ -89,3 +106,86 @@ func (it *ReplaceIf[TKey, TValue]) Size() int {
 func (it *ReplaceIf[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *ReplaceIf[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
+
+// ReplaceIfRand is a ReplaceIf, which can be moved to and read from arbitrary indices.
+type ReplaceIfRand[TKey any, TValue any] struct {
+	*ReplaceIf[TKey, TValue]
+	random compounditerators.ReadForRandIndexIterator[TKey, TValue]
+}
+
+func (it *ReplaceIfRand[TKey, TValue]) IsLast() bool {
+	return it.index == it.Size()-1
+}
+
+func (it *ReplaceIfRand[TKey, TValue]) Size() int {
+	return it.random.Size()
+}
+
+// position returns the index of it, which is Size() past the last element.
+func (it *ReplaceIfRand[TKey, TValue]) position() int {
+	if it.IsEnd() {
+		return it.Size()
+	}
+
+	return it.index
+}
+
+func (it *ReplaceIfRand[TKey, TValue]) MoveTo(i int) bool {
+	it.index = utils.Max(i, -1)
+	if it.Size() >= 0 {
+		it.index = utils.Min(it.index, it.Size())
+	}
+
+	found := it.random.MoveTo(it.index)
+
+	return found && it.IsValid()
+}
+
+func (it *ReplaceIfRand[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	value, found = it.random.GetAt(i)
+	if found && it.unaryPredicate(value) {
+		value = it.replacement
+	}
+
+	return value, found
+}
+
+func (it *ReplaceIfRand[TKey, TValue]) MoveToKey(key TKey) bool {
+	i, found := indexOfKey(it.random, key)
+	if !found {
+		return false
+	}
+
+	return it.MoveTo(i)
+}
+
+func (it *ReplaceIfRand[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	i, found := indexOfKey(it.random, key)
+	if !found {
+		return
+	}
+
+	return it.GetAt(i)
+}
+
+// ReplaceIfBidRand is a ReplaceIfRand, which can also be moved backward.
+type ReplaceIfBidRand[TKey any, TValue any] struct {
+	ReplaceIfRand[TKey, TValue]
+}
+
+func (it *ReplaceIfBidRand[TKey, TValue]) Previous() bool {
+	return it.MoveTo(it.position() - 1)
+}
+
+func (it *ReplaceIfBidRand[TKey, TValue]) PreviousN(n int) bool {
+	return it.MoveTo(it.position() - n)
+}
+
+func (it *ReplaceIfBidRand[TKey, TValue]) MoveBy(n int) bool {
+	return it.MoveTo(it.position() + n)
+}
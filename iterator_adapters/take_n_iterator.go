@@ -11,12 +11,30 @@ type TakeN[TKey any, TValue any] struct {
 	size  int
 }
 
+// NewTakeN returns a TakeNBidRand or TakeNRand, if inner supports the respective movements.
 func NewTakeN[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], n int) compounditerators.ReadForIndexIterator[TKey, TValue] {
-	return &TakeN[TKey, TValue]{
+	it := &TakeN[TKey, TValue]{
 		ReadForIndexIterator: inner,
 		index:                -1,
 		size:                 n,
 	}
+
+	random, ok := inner.(compounditerators.ReadForRandIndexIterator[TKey, TValue])
+	if !ok {
+		return it
+	}
+
+	if random.Size() >= 0 {
+		it.size = utils.Min(it.size, random.Size())
+	}
+
+	randomIt := TakeNRand[TKey, TValue]{TakeN: it, random: random}
+
+	if _, ok := inner.(compounditerators.ReadBidRandIndexIterator[TKey, TValue]); ok {
+		return &TakeNBidRand[TKey, TValue]{TakeNRand: randomIt}
+	}
+
+	return &randomIt
 }
 
 func (it *TakeN[TKey, TValue]) IsBegin() bool {
@@ -78,3 +96,73 @@ func (it *TakeN[TKey, TValue]) Size() int {
 func (it *TakeN[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *TakeN[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
+
+// TakeNRand is a TakeN, which can be moved to and read from arbitrary indices of the taken range.
+type TakeNRand[TKey any, TValue any] struct {
+	*TakeN[TKey, TValue]
+	random compounditerators.ReadForRandIndexIterator[TKey, TValue]
+}
+
+// position returns the index of it, which is Size() past the last element.
+func (it *TakeNRand[TKey, TValue]) position() int {
+	if it.IsEnd() {
+		return it.Size()
+	}
+
+	return it.index
+}
+
+func (it *TakeNRand[TKey, TValue]) MoveTo(i int) bool {
+	it.index = utils.Max(utils.Min(i, it.size), -1)
+	found := it.random.MoveTo(it.index)
+
+	return found && it.IsValid()
+}
+
+func (it *TakeNRand[TKey, TValue]) MoveToKey(key TKey) bool {
+	i, found := indexOfKey(it.random, key)
+	if !found || i >= it.size {
+		return false
+	}
+
+	return it.MoveTo(i)
+}
+
+func (it *TakeNRand[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	if i < 0 || i >= it.size {
+		return
+	}
+
+	return it.random.GetAt(i)
+}
+
+func (it *TakeNRand[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	i, found := indexOfKey(it.random, key)
+	if !found {
+		return
+	}
+
+	return it.GetAt(i)
+}
+
+// TakeNBidRand is a TakeNRand, which can also be moved backward.
+type TakeNBidRand[TKey any, TValue any] struct {
+	TakeNRand[TKey, TValue]
+}
+
+func (it *TakeNBidRand[TKey, TValue]) Previous() bool {
+	return it.MoveTo(it.position() - 1)
+}
+
+func (it *TakeNBidRand[TKey, TValue]) PreviousN(n int) bool {
+	return it.MoveTo(it.position() - n)
+}
+
+func (it *TakeNBidRand[TKey, TValue]) MoveBy(n int) bool {
+	return it.MoveTo(it.position() + n)
+}
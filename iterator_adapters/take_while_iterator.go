@@ -4,18 +4,27 @@ import (
 	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
+// TakeWhile looks at each element through a Peekable before moving to it, so the first element not satisfying unaryPredicate is not consumed.
+// If inner is a compounditerators.PeekIterator, that element can still be read from it after the TakeWhile has ended.
+// Index counts the taken elements starting at 0, Size is only known after the TakeWhile has ended.
 type TakeWhile[TKey any, TValue any] struct {
-	compounditerators.ReadForIndexIterator[TKey, TValue]
+	peekable       compounditerators.PeekIterator[TKey, TValue]
 	unaryPredicate func(value TValue) bool
 	index          int
 	size           int
+	done           bool
 }
 
 func NewTakeWhile[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], unaryPredicate func(TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	peekable, ok := inner.(compounditerators.PeekIterator[TKey, TValue])
+	if !ok {
+		peekable = NewPeekable[TKey, TValue](inner)
+	}
+
 	return &TakeWhile[TKey, TValue]{
-		ReadForIndexIterator: inner,
-		unaryPredicate:       unaryPredicate,
-		index:                -1,
+		peekable:       peekable,
+		unaryPredicate: unaryPredicate,
+		index:          -1,
 		// Will be set later
 		size: -1,
 	}
@@ -26,7 +35,7 @@ func (it *TakeWhile[TKey, TValue]) IsBegin() bool {
 }
 
 func (it *TakeWhile[TKey, TValue]) IsEnd() bool {
-	return it.size == 0 || it.index == it.size
+	return it.done || it.peekable.IsEnd()
 }
 
 func (it *TakeWhile[TKey, TValue]) IsFirst() bool {
@@ -42,21 +51,33 @@ func (it *TakeWhile[TKey, TValue]) IsValid() bool {
 }
 
 func (it *TakeWhile[TKey, TValue]) Get() (value TValue, found bool) {
-	return it.ReadForIndexIterator.Get()
+	return it.peekable.Get()
 }
 
 func (it *TakeWhile[TKey, TValue]) Next() bool {
-	found := it.ReadForIndexIterator.Next()
+	if it.done {
+		return false
+	}
 
-	value, _ := it.Get()
+	value, found := it.peekable.Peek()
+
+	if !found || !it.unaryPredicate(value) {
+		it.done = true
+		it.size = it.index + 1
+
+		return false
+	}
 
-	if !it.unaryPredicate(value) {
-		it.size = it.index
+	if !it.peekable.Next() {
+		it.done = true
+		it.size = it.index + 1
 
 		return false
 	}
 
-	return found
+	it.index++
+
+	return true
 }
 
 func (it *TakeWhile[TKey, TValue]) NextN(n int) bool {
@@ -82,3 +103,12 @@ func (it *TakeWhile[TKey, TValue]) Size() int {
 func (it *TakeWhile[TKey, TValue]) Index() (int, bool) {
 	return it.index, it.IsValid()
 }
+
+func (it *TakeWhile[TKey, TValue]) GetKey() (TKey, bool) {
+	return it.peekable.GetKey()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *TakeWhile[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.peekable)
+}
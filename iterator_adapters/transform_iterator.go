@@ -1,24 +1,40 @@
 package iteratoradapters
 
 import (
+	"github.com/JonasMuehlmann/datastructures.go/utils"
 	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
 )
 
 type Transform[TKey any, TValue any] struct {
 	compounditerators.ReadForIndexIterator[TKey, TValue]
 	transformer func(value TValue) (TValue, error)
+	err         error
 	index       int
 	size        int
 	done        bool
 }
 
+// NewTransformIterator returns a TransformBidRand or TransformRand, if inner supports the respective movements.
 func NewTransformIterator[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], transformer func(TValue) (TValue, error)) compounditerators.ReadForIndexIterator[TKey, TValue] {
-	return &Transform[TKey, TValue]{
+	it := &Transform[TKey, TValue]{
 		ReadForIndexIterator: inner,
 		transformer:          transformer,
 		index:                -1,
 		size:                 0,
 	}
+
+	random, ok := inner.(compounditerators.ReadForRandIndexIterator[TKey, TValue])
+	if !ok {
+		return it
+	}
+
+	randomIt := TransformRand[TKey, TValue]{Transform: it, random: random}
+
+	if _, ok := inner.(compounditerators.ReadBidRandIndexIterator[TKey, TValue]); ok {
+		return &TransformBidRand[TKey, TValue]{TransformRand: randomIt}
+	}
+
+	return &randomIt
 }
 
 func (it *Transform[TKey, TValue]) IsBegin() bool {
@@ -47,14 +63,17 @@ func (it *Transform[TKey, TValue]) Get() (value TValue, found bool) {
 
 	val, err = it.transformer(val)
 	if err != nil {
+		it.err = err
 		it.done = true
+
+		return val, false
 	}
 
 	return val, found
 }
 
 func (it *Transform[TKey, TValue]) Next() bool {
-	if it.ReadForIndexIterator.IsEnd() {
+	if it.done || it.ReadForIndexIterator.IsEnd() {
 		return false
 	}
 
@@ -93,3 +112,102 @@ func (it *Transform[TKey, TValue]) Size() int {
 func (it *Transform[TKey, TValue]) Index() (int, bool) {
 	return it.ReadForIndexIterator.Index()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Transform[TKey, TValue]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
+
+// TransformRand is a Transform, which can be moved to and read from arbitrary indices.
+type TransformRand[TKey any, TValue any] struct {
+	*Transform[TKey, TValue]
+	random compounditerators.ReadForRandIndexIterator[TKey, TValue]
+}
+
+func (it *TransformRand[TKey, TValue]) IsLast() bool {
+	return it.index == it.Size()-1
+}
+
+func (it *TransformRand[TKey, TValue]) Size() int {
+	return it.random.Size()
+}
+
+// position returns the index of it, which is Size() past the last element.
+func (it *TransformRand[TKey, TValue]) position() int {
+	if it.IsEnd() {
+		return it.Size()
+	}
+
+	return it.index
+}
+
+func (it *TransformRand[TKey, TValue]) MoveTo(i int) bool {
+	if it.done {
+		return false
+	}
+
+	it.index = utils.Max(i, -1)
+	if it.Size() >= 0 {
+		it.index = utils.Min(it.index, it.Size())
+	}
+
+	found := it.random.MoveTo(it.index)
+
+	return found && it.IsValid()
+}
+
+func (it *TransformRand[TKey, TValue]) GetAt(i int) (value TValue, found bool) {
+	value, found = it.random.GetAt(i)
+	if !found {
+		return
+	}
+
+	value, err := it.transformer(value)
+	if err != nil {
+		it.err = err
+		it.done = true
+
+		return value, false
+	}
+
+	return value, true
+}
+
+func (it *TransformRand[TKey, TValue]) MoveToKey(key TKey) bool {
+	i, found := indexOfKey(it.random, key)
+	if !found {
+		return false
+	}
+
+	return it.MoveTo(i)
+}
+
+func (it *TransformRand[TKey, TValue]) GetAtKey(key TKey) (value TValue, found bool) {
+	i, found := indexOfKey(it.random, key)
+	if !found {
+		return
+	}
+
+	return it.GetAt(i)
+}
+
+// TransformBidRand is a TransformRand, which can also be moved backward.
+type TransformBidRand[TKey any, TValue any] struct {
+	TransformRand[TKey, TValue]
+}
+
+func (it *TransformBidRand[TKey, TValue]) Previous() bool {
+	return it.MoveTo(it.position() - 1)
+}
+
+func (it *TransformBidRand[TKey, TValue]) PreviousN(n int) bool {
+	return it.MoveTo(it.position() - n)
+}
+
+func (it *TransformBidRand[TKey, TValue]) MoveBy(n int) bool {
+	return it.MoveTo(it.position() + n)
+}
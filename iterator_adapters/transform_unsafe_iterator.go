@@ -88,3 +88,8 @@ func (it *TransformUnsafe[TKey, TValue]) Size() int {
 func (it *TransformUnsafe[TKey, TValue]) Index() (int, bool) {
 	return it.ReadForIndexIterator.Index()
 }
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *TransformUnsafe[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
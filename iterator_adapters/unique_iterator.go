@@ -0,0 +1,92 @@
+package iteratoradapters
+
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// Unique skips elements of the inner iterator, which are equal to their predecessor according to binaryPredicate.
+type Unique[TKey any, TValue any] struct {
+	compounditerators.ReadForIndexIterator[TKey, TValue]
+	binaryPredicate func(TValue, TValue) bool
+	previous        TValue
+	index           int
+	done            bool
+}
+
+func NewUnique[TKey any, TValue any](inner compounditerators.ReadForIndexIterator[TKey, TValue], binaryPredicate func(TValue, TValue) bool) compounditerators.ReadForIndexIterator[TKey, TValue] {
+	return &Unique[TKey, TValue]{
+		ReadForIndexIterator: inner,
+		binaryPredicate:      binaryPredicate,
+		index:                -1,
+	}
+}
+
+func (it *Unique[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Unique[TKey, TValue]) IsEnd() bool {
+	return it.done || it.ReadForIndexIterator.IsEnd()
+}
+
+func (it *Unique[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Unique[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *Unique[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Unique[TKey, TValue]) Get() (value TValue, found bool) {
+	return it.ReadForIndexIterator.Get()
+}
+
+func (it *Unique[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	for it.ReadForIndexIterator.Next() {
+		value, _ := it.ReadForIndexIterator.Get()
+
+		if it.IsBegin() || !it.binaryPredicate(it.previous, value) {
+			it.previous = value
+			it.index++
+
+			return true
+		}
+	}
+
+	it.done = true
+
+	return false
+}
+
+func (it *Unique[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Unique[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *Unique[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Unique[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.ReadForIndexIterator)
+}
@@ -0,0 +1,172 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+	"github.com/barweiss/go-tuple"
+)
+
+// unzipQueue buffers the elements of one side of an unzipBuffer in FIFO order.
+// The remaining elements are moved to the front once the consumed ones take up more than half of the capacity,
+// so the memory of consumed elements is reused instead of growing the buffer forever.
+type unzipQueue[T any] struct {
+	values []T
+	head   int
+}
+
+func (queue *unzipQueue[T]) push(value T) {
+	queue.values = append(queue.values, value)
+}
+
+func (queue *unzipQueue[T]) pop() (value T, found bool) {
+	if queue.head == len(queue.values) {
+		return
+	}
+
+	value = queue.values[queue.head]
+
+	var zero T
+	queue.values[queue.head] = zero
+	queue.head++
+
+	if queue.head > cap(queue.values)/2 {
+		n := copy(queue.values, queue.values[queue.head:])
+		queue.values = queue.values[:n]
+		queue.head = 0
+	}
+
+	return value, true
+}
+
+// unzipBuffer is shared by the two iterators created by NewUnzip.
+// Elements pulled from the original iterator by one side are buffered until the other side consumes them,
+// so the buffer only grows as far as one side runs ahead of the other.
+type unzipBuffer[TKey any, TValue1 any, TValue2 any] struct {
+	original ds.ReadForIndexIterator[TKey, tuple.T2[TValue1, TValue2]]
+	pending1 unzipQueue[TValue1]
+	pending2 unzipQueue[TValue2]
+}
+
+func (buffer *unzipBuffer[TKey, TValue1, TValue2]) nextFirst() (value TValue1, found bool) {
+	if value, found = buffer.pending1.pop(); found {
+		return value, true
+	}
+
+	if !buffer.original.Next() {
+		return
+	}
+
+	pair, _ := buffer.original.Get()
+	buffer.pending2.push(pair.V2)
+
+	return pair.V1, true
+}
+
+func (buffer *unzipBuffer[TKey, TValue1, TValue2]) nextSecond() (value TValue2, found bool) {
+	if value, found = buffer.pending2.pop(); found {
+		return value, true
+	}
+
+	if !buffer.original.Next() {
+		return
+	}
+
+	pair, _ := buffer.original.Get()
+	buffer.pending1.push(pair.V1)
+
+	return pair.V2, true
+}
+
+// Unzip yields one component of the pairs of an iterator created with NewUnzip.
+type Unzip[TValue any] struct {
+	next    func() (TValue, bool)
+	err     func() error
+	current TValue
+	index   int
+	done    bool
+}
+
+// NewUnzip splits an iterator of pairs into two lazy iterators yielding the first and second components respectively.
+// Both iterators can be advanced independently, the elements the other one did not consume yet are buffered.
+func NewUnzip[TKey any, TValue1 any, TValue2 any](original ds.ReadForIndexIterator[TKey, tuple.T2[TValue1, TValue2]]) (ds.ReadForIndexIterator[int, TValue1], ds.ReadForIndexIterator[int, TValue2]) {
+	buffer := &unzipBuffer[TKey, TValue1, TValue2]{original: original}
+
+	err := func() error { return compounditerators.ErrOf(original) }
+
+	return &Unzip[TValue1]{next: buffer.nextFirst, err: err, index: -1}, &Unzip[TValue2]{next: buffer.nextSecond, err: err, index: -1}
+}
+
+func (it *Unzip[TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Unzip[TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Unzip[TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Unzip[TValue]) IsLast() bool {
+	return false
+}
+
+func (it *Unzip[TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Unzip[TValue]) Get() (value TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Unzip[TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	value, found := it.next()
+	if !found {
+		it.done = true
+
+		return false
+	}
+
+	it.current = value
+	it.index++
+
+	return true
+}
+
+func (it *Unzip[TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Unzip[TValue]) Size() int {
+	return -1
+}
+
+func (it *Unzip[TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Unzip[TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Unzip[TValue]) Err() error {
+	return it.err()
+}
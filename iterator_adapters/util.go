@@ -0,0 +1,17 @@
+package iteratoradapters
+
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// indexOfKey returns the index of key in random without changing the position of random.
+func indexOfKey[TKey any, TValue any](random compounditerators.ReadForRandIndexIterator[TKey, TValue], key TKey) (int, bool) {
+	current, _ := random.Index()
+	defer random.MoveTo(current)
+
+	if !random.MoveToKey(key) {
+		return 0, false
+	}
+
+	return random.Index()
+}
@@ -0,0 +1,142 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+)
+
+// Window yields slices of size consecutive elements of the original iterator, the start of each window advancing by step elements.
+// If step is less than size, the windows overlap, if it is greater, elements between the windows are skipped.
+// Trailing elements, which do not fill a whole window, are not yielded.
+// If reuseBuffer is set, every window is written into the same buffer, which is only valid until the next call to Next.
+// Otherwise, each window is newly allocated and can be retained by the caller.
+type Window[TKey any, TValue any] struct {
+	original    ds.ReadForIndexIterator[TKey, TValue]
+	size        int
+	step        int
+	reuseBuffer bool
+	current     []TValue
+	index       int
+	done        bool
+}
+
+// NewWindow creates a Window adapter, a non-positive size or step results in an empty iterator.
+func NewWindow[TKey any, TValue any](original ds.ReadForIndexIterator[TKey, TValue], size int, step int, reuseBuffer bool) ds.ReadForIndexIterator[int, []TValue] {
+	it := &Window[TKey, TValue]{
+		original:    original,
+		size:        size,
+		step:        step,
+		reuseBuffer: reuseBuffer,
+		index:       -1,
+		done:        size <= 0 || step <= 0,
+	}
+
+	if !it.done {
+		it.current = make([]TValue, 0, size)
+	}
+
+	return it
+}
+
+func (it *Window[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Window[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Window[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Window[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *Window[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Window[TKey, TValue]) Get() (value []TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Window[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	var window []TValue
+	if it.reuseBuffer {
+		window = it.current
+	} else {
+		window = make([]TValue, 0, it.size)
+	}
+
+	if !it.IsBegin() {
+		if it.step < it.size {
+			// Keep the overlap with the previous window
+			window = window[:copy(window[:it.size-it.step], it.current[it.step:])]
+		} else {
+			window = window[:0]
+
+			for i := it.size; i < it.step; i++ {
+				if !it.original.Next() {
+					it.done = true
+
+					return false
+				}
+			}
+		}
+	}
+
+	for len(window) < it.size {
+		if !it.original.Next() {
+			it.done = true
+
+			return false
+		}
+
+		value, _ := it.original.Get()
+		window = append(window, value)
+	}
+
+	it.current = window
+	it.index++
+
+	return true
+}
+
+func (it *Window[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Window[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *Window[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Window[TKey, TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Window[TKey, TValue]) Err() error {
+	return compounditerators.ErrOf(it.original)
+}
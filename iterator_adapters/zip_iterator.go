@@ -0,0 +1,271 @@
+package iteratoradapters
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+	"github.com/barweiss/go-tuple"
+)
+
+//******************************************************************//
+//                                Zip                               //
+//******************************************************************//
+
+// Zip walks two iterators in lockstep and yields pairs of their elements.
+// With the shortest policy, it stops as soon as one of the iterators ends.
+// With the longest policy, it stops after both iterators ended and substitutes the fill values for the missing elements.
+type Zip[TKey1 any, TValue1 any, TKey2 any, TValue2 any] struct {
+	first      ds.ReadForIndexIterator[TKey1, TValue1]
+	second     ds.ReadForIndexIterator[TKey2, TValue2]
+	fill1      TValue1
+	fill2      TValue2
+	current    tuple.T2[TValue1, TValue2]
+	longest    bool
+	firstDone  bool
+	secondDone bool
+	index      int
+	done       bool
+}
+
+func NewZip[TKey1 any, TValue1 any, TKey2 any, TValue2 any](first ds.ReadForIndexIterator[TKey1, TValue1], second ds.ReadForIndexIterator[TKey2, TValue2]) ds.ReadForIndexIterator[int, tuple.T2[TValue1, TValue2]] {
+	return &Zip[TKey1, TValue1, TKey2, TValue2]{
+		first:  first,
+		second: second,
+		index:  -1,
+	}
+}
+
+func NewZipLongest[TKey1 any, TValue1 any, TKey2 any, TValue2 any](first ds.ReadForIndexIterator[TKey1, TValue1], second ds.ReadForIndexIterator[TKey2, TValue2], fill1 TValue1, fill2 TValue2) ds.ReadForIndexIterator[int, tuple.T2[TValue1, TValue2]] {
+	return &Zip[TKey1, TValue1, TKey2, TValue2]{
+		first:   first,
+		second:  second,
+		fill1:   fill1,
+		fill2:   fill2,
+		longest: true,
+		index:   -1,
+	}
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) IsEnd() bool {
+	return it.done
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) IsLast() bool {
+	return false
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) Get() (value tuple.T2[TValue1, TValue2], found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if !it.firstDone && !it.first.Next() {
+		it.firstDone = true
+	}
+
+	// With the shortest policy, second must not be consumed past the end of first.
+	if !it.secondDone && (it.longest || !it.firstDone) && !it.second.Next() {
+		it.secondDone = true
+	}
+
+	if it.firstDone && it.secondDone || !it.longest && (it.firstDone || it.secondDone) {
+		it.done = true
+
+		return false
+	}
+
+	it.current = tuple.New2(it.fill1, it.fill2)
+
+	if !it.firstDone {
+		it.current.V1, _ = it.first.Get()
+	}
+
+	if !it.secondDone {
+		it.current.V2, _ = it.second.Get()
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) Size() int {
+	return -1
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *Zip[TKey1, TValue1, TKey2, TValue2]) Err() error {
+	if err := compounditerators.ErrOf(it.first); err != nil {
+		return err
+	}
+
+	return compounditerators.ErrOf(it.second)
+}
+
+//******************************************************************//
+//                               ZipN                               //
+//******************************************************************//
+
+// ZipN walks any number of iterators with the same element type in lockstep and yields slices of their elements.
+// With the shortest policy, it stops as soon as one of the iterators ends.
+// With the longest policy, it stops after all iterators ended and substitutes the fill value for the missing elements.
+// Each yielded slice is newly allocated and can be retained by the caller.
+type ZipN[TKey any, TValue any] struct {
+	originals []ds.ReadForIndexIterator[TKey, TValue]
+	fill      TValue
+	current   []TValue
+	longest   bool
+	ended     []bool
+	index     int
+	done      bool
+}
+
+func NewZipN[TKey any, TValue any](originals ...ds.ReadForIndexIterator[TKey, TValue]) ds.ReadForIndexIterator[int, []TValue] {
+	return &ZipN[TKey, TValue]{
+		originals: originals,
+		ended:     make([]bool, len(originals)),
+		index:     -1,
+	}
+}
+
+func NewZipNLongest[TKey any, TValue any](fill TValue, originals ...ds.ReadForIndexIterator[TKey, TValue]) ds.ReadForIndexIterator[int, []TValue] {
+	return &ZipN[TKey, TValue]{
+		originals: originals,
+		fill:      fill,
+		longest:   true,
+		ended:     make([]bool, len(originals)),
+		index:     -1,
+	}
+}
+
+func (it *ZipN[TKey, TValue]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *ZipN[TKey, TValue]) IsEnd() bool {
+	return it.done
+}
+
+func (it *ZipN[TKey, TValue]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *ZipN[TKey, TValue]) IsLast() bool {
+	return false
+}
+
+func (it *ZipN[TKey, TValue]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *ZipN[TKey, TValue]) Get() (value []TValue, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *ZipN[TKey, TValue]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	nEnded := 0
+	for i, original := range it.originals {
+		if !it.ended[i] && !original.Next() {
+			it.ended[i] = true
+		}
+
+		if it.ended[i] {
+			nEnded++
+		}
+	}
+
+	if nEnded == len(it.originals) || !it.longest && nEnded > 0 {
+		it.done = true
+
+		return false
+	}
+
+	it.current = make([]TValue, len(it.originals))
+	for i, original := range it.originals {
+		if it.ended[i] {
+			it.current[i] = it.fill
+		} else {
+			it.current[i], _ = original.Get()
+		}
+	}
+
+	it.index++
+
+	return true
+}
+
+func (it *ZipN[TKey, TValue]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *ZipN[TKey, TValue]) Size() int {
+	return -1
+}
+
+func (it *ZipN[TKey, TValue]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *ZipN[TKey, TValue]) GetKey() (int, bool) {
+	return it.Index()
+}
+
+// Err returns the error, which stopped the iteration, or nil.
+func (it *ZipN[TKey, TValue]) Err() error {
+	return firstErr(it.originals...)
+}
@@ -0,0 +1,311 @@
+package goaoi
+
+import (
+	"github.com/JonasMuehlmann/datastructures.go/ds"
+	"github.com/JonasMuehlmann/datastructures.go/utils"
+)
+
+// NeedleMatch describes an occurrence of one of multiple needles.
+type NeedleMatch struct {
+	// Needle is the index of the matched needle in the needles passed to the matcher
+	Needle int
+	// Index is the beginning of the occurrence in the haystack
+	Index int
+}
+
+type ahoCorasickNode[T comparable] struct {
+	children map[T]int
+	fail     int
+	// Indices of all needles ending in this node, longest first
+	outputs []int
+}
+
+//******************************************************************//
+//                        AhoCorasickMatcher                        //
+//******************************************************************//
+
+// AhoCorasickMatcher finds occurrences of many needles at once using the Aho-Corasick algorithm.
+// After preparation, a haystack of length n is searched in O(n + number of matches) steps, regardless of the number of needles.
+// Because the transitions are indexed by element, the elements are compared with == instead of a predicate.
+// Empty needles never match.
+type AhoCorasickMatcher[T comparable] struct {
+	nodes         []ahoCorasickNode[T]
+	needleLengths []int
+	maxLength     int
+}
+
+// NewAhoCorasickMatcher prepares needles for searches with the Aho-Corasick algorithm.
+func NewAhoCorasickMatcher[T comparable](needles [][]T) *AhoCorasickMatcher[T] {
+	matcher := &AhoCorasickMatcher[T]{
+		nodes:         []ahoCorasickNode[T]{{children: make(map[T]int)}},
+		needleLengths: make([]int, len(needles)),
+	}
+
+	for i, needle := range needles {
+		matcher.needleLengths[i] = len(needle)
+		matcher.maxLength = utils.Max(matcher.maxLength, len(needle))
+
+		if len(needle) == 0 {
+			continue
+		}
+
+		state := 0
+		for _, value := range needle {
+			next, ok := matcher.nodes[state].children[value]
+			if !ok {
+				next = len(matcher.nodes)
+				matcher.nodes = append(matcher.nodes, ahoCorasickNode[T]{children: make(map[T]int)})
+				matcher.nodes[state].children[value] = next
+			}
+
+			state = next
+		}
+
+		matcher.nodes[state].outputs = append(matcher.nodes[state].outputs, i)
+	}
+
+	// Breadth first traversal, so that the fail link of a node is always computed before it's children
+	queue := make([]int, 0, len(matcher.nodes))
+	for _, child := range matcher.nodes[0].children {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		for value, child := range matcher.nodes[state].children {
+			fail := matcher.nodes[state].fail
+			for fail != 0 && !matcher.hasChild(fail, value) {
+				fail = matcher.nodes[fail].fail
+			}
+
+			if next, ok := matcher.nodes[fail].children[value]; ok {
+				fail = next
+			} else {
+				fail = 0
+			}
+
+			matcher.nodes[child].fail = fail
+			matcher.nodes[child].outputs = append(matcher.nodes[child].outputs, matcher.nodes[fail].outputs...)
+
+			queue = append(queue, child)
+		}
+	}
+
+	return matcher
+}
+
+func (matcher *AhoCorasickMatcher[T]) hasChild(state int, value T) bool {
+	_, ok := matcher.nodes[state].children[value]
+
+	return ok
+}
+
+// step follows the transition for value from state, falling back along the fail links if necessary.
+func (matcher *AhoCorasickMatcher[T]) step(state int, value T) int {
+	for {
+		if next, ok := matcher.nodes[state].children[value]; ok {
+			return next
+		}
+
+		if state == 0 {
+			return 0
+		}
+
+		state = matcher.nodes[state].fail
+	}
+}
+
+func (matcher *AhoCorasickMatcher[T]) findFirst(n int, at func(int) T) (NeedleMatch, error) {
+	var best NeedleMatch
+
+	if n == 0 || matcher.maxLength == 0 {
+		return best, EmptyIterableError{}
+	}
+
+	found := false
+	state := 0
+
+	for i := 0; i < n; i++ {
+		// No match ending after i can start before the best one
+		if found && i+1-matcher.maxLength > best.Index {
+			break
+		}
+
+		state = matcher.step(state, at(i))
+
+		for _, needle := range matcher.nodes[state].outputs {
+			start := i + 1 - matcher.needleLengths[needle]
+			if !found || start < best.Index || start == best.Index && needle < best.Needle {
+				best = NeedleMatch{Needle: needle, Index: start}
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		return best, ElementNotFoundError{}
+	}
+
+	return best, nil
+}
+
+// FindFirst finds the leftmost occurrence of any needle in haystack.
+// If multiple needles occur at the same index, the one passed first to the matcher is reported.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func (matcher *AhoCorasickMatcher[T]) FindFirst(haystack []T) (NeedleMatch, error) {
+	return matcher.findFirst(len(haystack), func(i int) T { return haystack[i] })
+}
+
+// FindAll returns a lazy iterator yielding all, possibly overlapping, occurrences of all needles in haystack.
+// The occurrences are ordered by the index they end at, occurrences ending at the same index are ordered from longest to shortest.
+func (matcher *AhoCorasickMatcher[T]) FindAll(haystack []T) ds.ReadForIndexIterator[int, NeedleMatch] {
+	return newAhoCorasickMatches(matcher, len(haystack), func(i int) T { return haystack[i] })
+}
+
+//******************************************************************//
+//                     AhoCorasickStringMatcher                     //
+//******************************************************************//
+
+// AhoCorasickStringMatcher finds occurrences of many needles at once in strings using the Aho-Corasick algorithm.
+// After preparation, a haystack of length n is searched in O(n + number of matches) steps, regardless of the number of needles.
+// The needles are matched byte by byte, empty needles never match.
+type AhoCorasickStringMatcher struct {
+	matcher *AhoCorasickMatcher[byte]
+}
+
+// NewAhoCorasickStringMatcher prepares needles for searches with the Aho-Corasick algorithm.
+func NewAhoCorasickStringMatcher(needles []string) *AhoCorasickStringMatcher {
+	needleBytes := make([][]byte, len(needles))
+	for i, needle := range needles {
+		needleBytes[i] = []byte(needle)
+	}
+
+	return &AhoCorasickStringMatcher{matcher: NewAhoCorasickMatcher(needleBytes)}
+}
+
+// FindFirst finds the leftmost occurrence of any needle in haystack.
+// If multiple needles occur at the same index, the one passed first to the matcher is reported.
+//
+// Possible Error values:
+//   - EmptyIterableError
+//   - ElementNotFoundError
+func (matcher *AhoCorasickStringMatcher) FindFirst(haystack string) (NeedleMatch, error) {
+	return matcher.matcher.findFirst(len(haystack), func(i int) byte { return haystack[i] })
+}
+
+// FindAll returns a lazy iterator yielding all, possibly overlapping, occurrences of all needles in haystack.
+// The occurrences are ordered by the index they end at, occurrences ending at the same index are ordered from longest to shortest.
+func (matcher *AhoCorasickStringMatcher) FindAll(haystack string) ds.ReadForIndexIterator[int, NeedleMatch] {
+	return newAhoCorasickMatches(matcher.matcher, len(haystack), func(i int) byte { return haystack[i] })
+}
+
+//******************************************************************//
+//                        ahoCorasickMatches                        //
+//******************************************************************//
+
+type ahoCorasickMatches[T comparable] struct {
+	matcher   *AhoCorasickMatcher[T]
+	at        func(int) T
+	n         int
+	position  int
+	state     int
+	pending   []int
+	iPending  int
+	current   NeedleMatch
+	index     int
+	exhausted bool
+}
+
+func newAhoCorasickMatches[T comparable](matcher *AhoCorasickMatcher[T], n int, at func(int) T) ds.ReadForIndexIterator[int, NeedleMatch] {
+	return &ahoCorasickMatches[T]{
+		matcher: matcher,
+		at:      at,
+		n:       n,
+		index:   -1,
+	}
+}
+
+func (it *ahoCorasickMatches[T]) IsBegin() bool {
+	return it.index == -1
+}
+
+func (it *ahoCorasickMatches[T]) IsEnd() bool {
+	return it.exhausted
+}
+
+func (it *ahoCorasickMatches[T]) IsFirst() bool {
+	return it.index == 0
+}
+
+func (it *ahoCorasickMatches[T]) IsLast() bool {
+	return false
+}
+
+func (it *ahoCorasickMatches[T]) IsValid() bool {
+	return !it.IsBegin() && !it.IsEnd()
+}
+
+func (it *ahoCorasickMatches[T]) Get() (value NeedleMatch, found bool) {
+	if !it.IsValid() {
+		return
+	}
+
+	return it.current, true
+}
+
+func (it *ahoCorasickMatches[T]) Next() bool {
+	if it.IsEnd() {
+		return false
+	}
+
+	for it.iPending == len(it.pending) {
+		if it.position == it.n {
+			it.exhausted = true
+
+			return false
+		}
+
+		it.state = it.matcher.step(it.state, it.at(it.position))
+		it.position++
+
+		it.pending = it.matcher.nodes[it.state].outputs
+		it.iPending = 0
+	}
+
+	needle := it.pending[it.iPending]
+	it.iPending++
+
+	it.current = NeedleMatch{Needle: needle, Index: it.position - it.matcher.needleLengths[needle]}
+	it.index++
+
+	return true
+}
+
+func (it *ahoCorasickMatches[T]) NextN(n int) bool {
+	for i := 0; i < n; i++ {
+		found := it.Next()
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *ahoCorasickMatches[T]) Size() int {
+	return -1
+}
+
+func (it *ahoCorasickMatches[T]) Index() (int, bool) {
+	return it.index, it.IsValid()
+}
+
+func (it *ahoCorasickMatches[T]) GetKey() (int, bool) {
+	return it.Index()
+}
@@ -0,0 +1,80 @@
+package goaoi_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/datastructures.go/lists/arraylist"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AhoCorasickMatcher(t *testing.T) {
+	tcs := []struct {
+		haystack []int
+		needles  [][]int
+		exp      goaoi.NeedleMatch
+		expAll   []goaoi.NeedleMatch
+		err      error
+		name     string
+	}{
+		{[]int{1, 2, 3, 4}, [][]int{{3, 4}, {2}}, goaoi.NeedleMatch{Needle: 1, Index: 1}, []goaoi.NeedleMatch{{Needle: 1, Index: 1}, {Needle: 0, Index: 2}}, nil, "Two needles"},
+		{[]int{1, 2, 3, 4}, [][]int{{2, 3}, {1, 2, 3, 4}}, goaoi.NeedleMatch{Needle: 1, Index: 0}, []goaoi.NeedleMatch{{Needle: 0, Index: 1}, {Needle: 1, Index: 0}}, nil, "Leftmost wins over first ending"},
+		{[]int{1, 1, 1}, [][]int{{1, 1}}, goaoi.NeedleMatch{Needle: 0, Index: 0}, []goaoi.NeedleMatch{{Needle: 0, Index: 0}, {Needle: 0, Index: 1}}, nil, "Overlapping"},
+		{[]int{1, 2, 1, 2, 3}, [][]int{{1, 2, 3}, {2, 3}}, goaoi.NeedleMatch{Needle: 0, Index: 2}, []goaoi.NeedleMatch{{Needle: 0, Index: 2}, {Needle: 1, Index: 3}}, nil, "Suffix needle via fail link"},
+		{[]int{1, 2}, [][]int{{1}, {1, 2}}, goaoi.NeedleMatch{Needle: 0, Index: 0}, []goaoi.NeedleMatch{{Needle: 0, Index: 0}, {Needle: 1, Index: 0}}, nil, "Same start, first needle wins"},
+		{[]int{1, 2}, [][]int{{3}, {}}, goaoi.NeedleMatch{}, []goaoi.NeedleMatch{}, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{}, [][]int{{1}}, goaoi.NeedleMatch{}, []goaoi.NeedleMatch{}, goaoi.EmptyIterableError{}, "Haystack empty"},
+		{[]int{1}, [][]int{}, goaoi.NeedleMatch{}, []goaoi.NeedleMatch{}, goaoi.EmptyIterableError{}, "No needles"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			matcher := goaoi.NewAhoCorasickMatcher(tc.needles)
+			res, err := goaoi.FindFirstOfSliceWith(tc.haystack, matcher)
+			resAll := arraylist.NewFromIterator[goaoi.NeedleMatch](matcher.FindAll(tc.haystack)).GetSlice()
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.expAll, resAll)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
+
+func Test_AhoCorasickStringMatcher(t *testing.T) {
+	tcs := []struct {
+		haystack string
+		needles  []string
+		exp      goaoi.NeedleMatch
+		expAll   []goaoi.NeedleMatch
+		err      error
+		name     string
+	}{
+		{"ushers", []string{"he", "she", "his", "hers"}, goaoi.NeedleMatch{Needle: 1, Index: 1}, []goaoi.NeedleMatch{{Needle: 1, Index: 1}, {Needle: 0, Index: 2}, {Needle: 3, Index: 2}}, nil, "Classic example"},
+		{"abc", []string{"x", "y"}, goaoi.NeedleMatch{}, []goaoi.NeedleMatch{}, goaoi.ElementNotFoundError{}, "Not found"},
+		{"", []string{"a"}, goaoi.NeedleMatch{}, []goaoi.NeedleMatch{}, goaoi.EmptyIterableError{}, "Haystack empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			matcher := goaoi.NewAhoCorasickStringMatcher(tc.needles)
+			res, err := goaoi.FindFirstOfStringWith(tc.haystack, matcher)
+			resAll := arraylist.NewFromIterator[goaoi.NeedleMatch](matcher.FindAll(tc.haystack)).GetSlice()
+
+			assert.Equal(t, tc.exp, res)
+			assert.Equal(t, tc.expAll, resAll)
+			if tc.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorAs(t, err, &tc.err)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package goaoi
+
+// Searcher finds occurrences of a prepared needle in slices.
+// Preparing the needle once allows repeated searches in linear time without allocations.
+type Searcher[T any] interface {
+	// Search finds the beginning of the first occurrence of the needle in haystack.
+	//
+	// Possible Error values:
+	//   - EmptyIterableError
+	//   - ElementNotFoundError
+	Search(haystack []T) (int, error)
+
+	// SearchLast finds the beginning of the last occurrence of the needle in haystack.
+	//
+	// Possible Error values:
+	//   - EmptyIterableError
+	//   - ElementNotFoundError
+	SearchLast(haystack []T) (int, error)
+}
+
+// StringSearcher finds occurrences of a prepared needle in strings.
+// Preparing the needle once allows repeated searches in linear time without allocations.
+type StringSearcher interface {
+	// Search finds the beginning of the first occurrence of the needle in haystack.
+	//
+	// Possible Error values:
+	//   - EmptyIterableError
+	//   - ElementNotFoundError
+	Search(haystack string) (int, error)
+
+	// SearchLast finds the beginning of the last occurrence of the needle in haystack.
+	//
+	// Possible Error values:
+	//   - EmptyIterableError
+	//   - ElementNotFoundError
+	SearchLast(haystack string) (int, error)
+}
+
+//******************************************************************//
+//                           KMPSearcher                            //
+//******************************************************************//
+
+// KMPSearcher implements the Knuth-Morris-Pratt algorithm.
+// Searching takes O(n) comparisons for a haystack of length n, regardless of the needle.
+type KMPSearcher[T any] struct {
+	needle          []T
+	failure         []int
+	binaryPredicate func(T, T) bool
+}
+
+// NewKMPSearcher prepares needle for searches with the Knuth-Morris-Pratt algorithm.
+// The elements are compared with binary_predicate, which should report equality.
+func NewKMPSearcher[T any](needle []T, binary_predicate func(T, T) bool) *KMPSearcher[T] {
+	return &KMPSearcher[T]{
+		needle:          needle,
+		failure:         kmpFailureTable(len(needle), func(i int, j int) bool { return binary_predicate(needle[i], needle[j]) }),
+		binaryPredicate: binary_predicate,
+	}
+}
+
+// kmpFailureTable computes the length of the longest proper prefix of needle[:i+1], which is also a suffix of it, for each i.
+// The needle elements at two indices are compared with equal.
+func kmpFailureTable(n int, equal func(int, int) bool) []int {
+	failure := make([]int, n)
+
+	k := 0
+	for i := 1; i < n; i++ {
+		for k > 0 && !equal(i, k) {
+			k = failure[k-1]
+		}
+
+		if equal(i, k) {
+			k++
+		}
+
+		failure[i] = k
+	}
+
+	return failure
+}
+
+func (searcher *KMPSearcher[T]) search(haystack []T, findLast bool) (int, error) {
+	if len(haystack) == 0 || len(searcher.needle) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	found := -1
+
+	k := 0
+	for i, value := range haystack {
+		for k > 0 && !searcher.binaryPredicate(value, searcher.needle[k]) {
+			k = searcher.failure[k-1]
+		}
+
+		if searcher.binaryPredicate(value, searcher.needle[k]) {
+			k++
+		}
+
+		if k == len(searcher.needle) {
+			found = i - k + 1
+			if !findLast {
+				break
+			}
+
+			k = searcher.failure[k-1]
+		}
+	}
+
+	if found == -1 {
+		return 0, ElementNotFoundError{}
+	}
+
+	return found, nil
+}
+
+func (searcher *KMPSearcher[T]) Search(haystack []T) (int, error) {
+	return searcher.search(haystack, false)
+}
+
+func (searcher *KMPSearcher[T]) SearchLast(haystack []T) (int, error) {
+	return searcher.search(haystack, true)
+}
+
+//******************************************************************//
+//                        KMPStringSearcher                         //
+//******************************************************************//
+
+// KMPStringSearcher implements the Knuth-Morris-Pratt algorithm for strings.
+// Searching takes O(n) comparisons for a haystack of length n, regardless of the needle.
+type KMPStringSearcher struct {
+	needle          string
+	failure         []int
+	binaryPredicate func(byte, byte) bool
+}
+
+// NewKMPStringSearcher prepares needle for searches with the Knuth-Morris-Pratt algorithm.
+// The elements are compared with binary_predicate, which should report equality.
+func NewKMPStringSearcher(needle string, binary_predicate func(byte, byte) bool) *KMPStringSearcher {
+	return &KMPStringSearcher{
+		needle:          needle,
+		failure:         kmpFailureTable(len(needle), func(i int, j int) bool { return binary_predicate(needle[i], needle[j]) }),
+		binaryPredicate: binary_predicate,
+	}
+}
+
+func (searcher *KMPStringSearcher) search(haystack string, findLast bool) (int, error) {
+	if len(haystack) == 0 || len(searcher.needle) == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	found := -1
+
+	k := 0
+	for i := 0; i < len(haystack); i++ {
+		for k > 0 && !searcher.binaryPredicate(haystack[i], searcher.needle[k]) {
+			k = searcher.failure[k-1]
+		}
+
+		if searcher.binaryPredicate(haystack[i], searcher.needle[k]) {
+			k++
+		}
+
+		if k == len(searcher.needle) {
+			found = i - k + 1
+			if !findLast {
+				break
+			}
+
+			k = searcher.failure[k-1]
+		}
+	}
+
+	if found == -1 {
+		return 0, ElementNotFoundError{}
+	}
+
+	return found, nil
+}
+
+func (searcher *KMPStringSearcher) Search(haystack string) (int, error) {
+	return searcher.search(haystack, false)
+}
+
+func (searcher *KMPStringSearcher) SearchLast(haystack string) (int, error) {
+	return searcher.search(haystack, true)
+}
+
+//******************************************************************//
+//                         HorspoolSearcher                         //
+//******************************************************************//
+
+// HorspoolSearcher implements the Boyer-Moore-Horspool algorithm.
+// Searching skips up to len(needle) elements at once, which is sublinear on average for long needles.
+// Because the skip table is indexed by element, the elements are compared with == instead of a predicate.
+type HorspoolSearcher[T comparable] struct {
+	needle []T
+	// Shifts when scanning forward, indexed by the element below the last needle position
+	shift map[T]int
+	// Shifts when scanning backward, indexed by the element below the first needle position
+	shiftReverse map[T]int
+}
+
+// NewHorspoolSearcher prepares needle for searches with the Boyer-Moore-Horspool algorithm.
+func NewHorspoolSearcher[T comparable](needle []T) *HorspoolSearcher[T] {
+	searcher := &HorspoolSearcher[T]{
+		needle:       needle,
+		shift:        make(map[T]int, len(needle)),
+		shiftReverse: make(map[T]int, len(needle)),
+	}
+
+	for i := 0; i < len(needle)-1; i++ {
+		searcher.shift[needle[i]] = len(needle) - 1 - i
+	}
+
+	for i := len(needle) - 1; i > 0; i-- {
+		searcher.shiftReverse[needle[i]] = i
+	}
+
+	return searcher
+}
+
+func (searcher *HorspoolSearcher[T]) Search(haystack []T) (int, error) {
+	m := len(searcher.needle)
+	if len(haystack) == 0 || m == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := 0; i <= len(haystack)-m; {
+		j := m - 1
+		for j >= 0 && haystack[i+j] == searcher.needle[j] {
+			j--
+		}
+
+		if j < 0 {
+			return i, nil
+		}
+
+		shift, ok := searcher.shift[haystack[i+m-1]]
+		if !ok {
+			shift = m
+		}
+
+		i += shift
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+func (searcher *HorspoolSearcher[T]) SearchLast(haystack []T) (int, error) {
+	m := len(searcher.needle)
+	if len(haystack) == 0 || m == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := len(haystack) - m; i >= 0; {
+		j := 0
+		for j < m && haystack[i+j] == searcher.needle[j] {
+			j++
+		}
+
+		if j == m {
+			return i, nil
+		}
+
+		shift, ok := searcher.shiftReverse[haystack[i]]
+		if !ok {
+			shift = m
+		}
+
+		i -= shift
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+//******************************************************************//
+//                      HorspoolStringSearcher                      //
+//******************************************************************//
+
+// HorspoolStringSearcher implements the Boyer-Moore-Horspool algorithm for strings.
+// Searching skips up to len(needle) bytes at once, which is sublinear on average for long needles.
+type HorspoolStringSearcher struct {
+	needle       string
+	shift        [256]int
+	shiftReverse [256]int
+}
+
+// NewHorspoolStringSearcher prepares needle for searches with the Boyer-Moore-Horspool algorithm.
+func NewHorspoolStringSearcher(needle string) *HorspoolStringSearcher {
+	searcher := &HorspoolStringSearcher{needle: needle}
+
+	for i := range searcher.shift {
+		searcher.shift[i] = len(needle)
+		searcher.shiftReverse[i] = len(needle)
+	}
+
+	for i := 0; i < len(needle)-1; i++ {
+		searcher.shift[needle[i]] = len(needle) - 1 - i
+	}
+
+	for i := len(needle) - 1; i > 0; i-- {
+		searcher.shiftReverse[needle[i]] = i
+	}
+
+	return searcher
+}
+
+func (searcher *HorspoolStringSearcher) Search(haystack string) (int, error) {
+	m := len(searcher.needle)
+	if len(haystack) == 0 || m == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := 0; i <= len(haystack)-m; i += searcher.shift[haystack[i+m-1]] {
+		if haystack[i:i+m] == searcher.needle {
+			return i, nil
+		}
+	}
+
+	return 0, ElementNotFoundError{}
+}
+
+func (searcher *HorspoolStringSearcher) SearchLast(haystack string) (int, error) {
+	m := len(searcher.needle)
+	if len(haystack) == 0 || m == 0 {
+		return 0, EmptyIterableError{}
+	}
+
+	for i := len(haystack) - m; i >= 0; i -= searcher.shiftReverse[haystack[i]] {
+		if haystack[i:i+m] == searcher.needle {
+			return i, nil
+		}
+	}
+
+	return 0, ElementNotFoundError{}
+}
@@ -0,0 +1,116 @@
+package goaoi_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/goaoi/functional"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SliceSearchers(t *testing.T) {
+	tcs := []struct {
+		super   []int
+		sub     []int
+		exp     int
+		expLast int
+		err     error
+		name    string
+	}{
+		{[]int{1, 2, 3, 4}, []int{3, 4}, 2, 2, nil, "Found at end"},
+		{[]int{1, 2, 1, 2, 1}, []int{1, 2}, 0, 2, nil, "Found multiple"},
+		{[]int{1, 2, 1, 2, 1}, []int{1, 2, 1}, 0, 2, nil, "Found overlapping"},
+		{[]int{1, 1, 1, 2, 1, 1, 2}, []int{1, 1, 2}, 1, 4, nil, "Found after partial match"},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0, 0, nil, "Found equal"},
+		{[]int{1, 2, 3}, []int{1, 4}, 0, 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{[]int{1, 2}, []int{1, 2, 3}, 0, 0, goaoi.ElementNotFoundError{}, "Sub longer than super"},
+		{[]int{}, []int{1, 2, 3}, 0, 0, goaoi.EmptyIterableError{}, "Super empty"},
+		{[]int{1, 2, 3}, []int{}, 0, 0, goaoi.EmptyIterableError{}, "Sub empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			searchers := []goaoi.Searcher[int]{
+				goaoi.NewKMPSearcher(tc.sub, functional.AreEqual[int]),
+				goaoi.NewHorspoolSearcher(tc.sub),
+			}
+
+			for _, searcher := range searchers {
+				res, err := goaoi.SearchSliceWith(tc.super, searcher)
+				resLast, errLast := goaoi.FindEndSliceWith(tc.super, searcher)
+
+				assert.Equal(t, tc.exp, res)
+				assert.Equal(t, tc.expLast, resLast)
+				if tc.err == nil {
+					assert.Nil(t, err)
+					assert.Nil(t, errLast)
+				} else {
+					assert.ErrorAs(t, err, &tc.err)
+					assert.ErrorAs(t, errLast, &tc.err)
+				}
+			}
+		})
+	}
+}
+
+func Test_StringSearchers(t *testing.T) {
+	tcs := []struct {
+		super   string
+		sub     string
+		exp     int
+		expLast int
+		err     error
+		name    string
+	}{
+		{"abcabc", "bc", 1, 4, nil, "Found multiple"},
+		{"aaabaab", "aab", 1, 4, nil, "Found after partial match"},
+		{"ababa", "aba", 0, 2, nil, "Found overlapping"},
+		{"abc", "abc", 0, 0, nil, "Found equal"},
+		{"abc", "ac", 0, 0, goaoi.ElementNotFoundError{}, "Not found"},
+		{"", "a", 0, 0, goaoi.EmptyIterableError{}, "Super empty"},
+		{"a", "", 0, 0, goaoi.EmptyIterableError{}, "Sub empty"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			searchers := []goaoi.StringSearcher{
+				goaoi.NewKMPStringSearcher(tc.sub, functional.AreEqual[byte]),
+				goaoi.NewHorspoolStringSearcher(tc.sub),
+			}
+
+			for _, searcher := range searchers {
+				res, err := goaoi.SearchStringWith(tc.super, searcher)
+				resLast, errLast := goaoi.FindEndStringWith(tc.super, searcher)
+
+				assert.Equal(t, tc.exp, res)
+				assert.Equal(t, tc.expLast, resLast)
+				if tc.err == nil {
+					assert.Nil(t, err)
+					assert.Nil(t, errLast)
+				} else {
+					assert.ErrorAs(t, err, &tc.err)
+					assert.ErrorAs(t, errLast, &tc.err)
+				}
+			}
+		})
+	}
+}
+
+func Test_SearchersDoNotAllocate(t *testing.T) {
+	haystack := []int{1, 1, 1, 2, 1, 1, 2, 3}
+	kmp := goaoi.NewKMPSearcher([]int{1, 2, 3}, functional.AreEqual[int])
+	horspool := goaoi.NewHorspoolSearcher([]int{1, 2, 3})
+	kmpString := goaoi.NewKMPStringSearcher("abc", functional.AreEqual[byte])
+	horspoolString := goaoi.NewHorspoolStringSearcher("abc")
+
+	allocs := testing.AllocsPerRun(10, func() {
+		_, _ = kmp.Search(haystack)
+		_, _ = horspool.Search(haystack)
+		_, _ = kmpString.Search("aabaabc")
+		_, _ = horspoolString.SearchLast("aabaabc")
+	})
+
+	assert.Zero(t, allocs)
+}
@@ -1,6 +1,10 @@
 package goaoi
 
-import "golang.org/x/exp/constraints"
+import (
+	compounditerators "github.com/JonasMuehlmann/goaoi/compound_iterators"
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
 
 func min[T constraints.Ordered](x T, y T) T {
 	if x < y {
@@ -8,3 +12,176 @@ func min[T constraints.Ordered](x T, y T) T {
 	}
 	return y
 }
+
+// insertionSort sorts container in place, it is used for small ranges where it beats more complex algorithms.
+func insertionSort[T any](container []T, less func(T, T) bool) {
+	for i := 1; i < len(container); i++ {
+		for j := i; j > 0 && less(container[j], container[j-1]); j-- {
+			container[j], container[j-1] = container[j-1], container[j]
+		}
+	}
+}
+
+// medianOfThree returns the median of the first, middle and last element of container.
+func medianOfThree[T any](container []T, less func(T, T) bool) T {
+	a, b, c := container[0], container[len(container)/2], container[len(container)-1]
+
+	if less(b, a) {
+		a, b = b, a
+	}
+	if less(c, b) {
+		b = c
+		if less(b, a) {
+			b = a
+		}
+	}
+
+	return b
+}
+
+// nthElement implements quickselect with a three-way partition,
+// so that ranges of equal elements do not degrade performance.
+func nthElement[T any](container []T, n int, less func(T, T) bool) {
+	lo, hi := 0, len(container)-1
+
+	for hi-lo >= 16 {
+		pivot := medianOfThree(container[lo:hi+1], less)
+
+		lt, i, gt := lo, lo, hi
+		for i <= gt {
+			if less(container[i], pivot) {
+				container[lt], container[i] = container[i], container[lt]
+				lt++
+				i++
+			} else if less(pivot, container[i]) {
+				container[i], container[gt] = container[gt], container[i]
+				gt--
+			} else {
+				i++
+			}
+		}
+
+		if n < lt {
+			hi = lt - 1
+		} else if n > gt {
+			lo = gt + 1
+		} else {
+			return
+		}
+	}
+
+	insertionSort(container[lo:hi+1], less)
+}
+
+// partitionPointIndex finds the first index i in [0, n[ for which unaryPredicate(i) == false using binary search.
+// unaryPredicate must be true for a prefix of [0, n[ and false for the rest.
+// If unaryPredicate is true for all indices, n is returned.
+func partitionPointIndex(n int, unaryPredicate func(int) bool) int {
+	lo, hi := 0, n
+
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if unaryPredicate(mid) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
+
+// siftUp restores the heap property for container[:i+1] after container[i] was changed.
+func siftUp[T any](container []T, i int, less func(T, T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(container[parent], container[i]) {
+			return
+		}
+
+		container[parent], container[i] = container[i], container[parent]
+		i = parent
+	}
+}
+
+// siftDown restores the heap property for container[:n] after container[i] was changed.
+func siftDown[T any](container []T, i int, n int, less func(T, T) bool) {
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && less(container[largest], container[left]) {
+			largest = left
+		}
+		if right < n && less(container[largest], container[right]) {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+
+		container[i], container[largest] = container[largest], container[i]
+		i = largest
+	}
+}
+
+func reverse[T any](container []T) {
+	for i, j := 0, len(container)-1; i < j; i, j = i+1, j-1 {
+		container[i], container[j] = container[j], container[i]
+	}
+}
+
+// compareLengths returns -1, 0 or 1 if n1 is less than, equal to or greater than n2.
+func compareLengths(n1 int, n2 int) int {
+	switch {
+	case n1 < n2:
+		return -1
+	case n1 > n2:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortedKeys returns the keys of container in ascending order.
+func sortedKeys[TKey constraints.Ordered, TValue any](container map[TKey]TValue) []TKey {
+	keys := make([]TKey, 0, len(container))
+	for key := range container {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// iteratorError wraps the error, which stopped the iteration of container, after n consumed elements.
+// It returns nil, if container does not implement compounditerators.ErrIterator or did not fail.
+func iteratorError[TValue any](container any, n int) error {
+	err := compounditerators.ErrOf(container)
+	if err == nil {
+		return nil
+	}
+
+	return ExecutionError[int, TValue]{BadItemIndex: n, Inner: err}
+}
+
+// getError wraps the reason, why Get failed for the nth element of container, although Next succeeded.
+// The error of container is used, if it implements compounditerators.ErrIterator and failed, ElementNotFoundError otherwise.
+func getError[TValue any](container any, n int) error {
+	err := compounditerators.ErrOf(container)
+	if err == nil {
+		err = ElementNotFoundError{}
+	}
+
+	return ExecutionError[int, TValue]{BadItemIndex: n, Inner: err}
+}
+
+// iteratorsError works like iteratorError for two iterables, which were consumed in lockstep.
+func iteratorsError[TValue any](iterable1 any, iterable2 any, n int) error {
+	if err := iteratorError[TValue](iterable1, n); err != nil {
+		return err
+	}
+
+	return iteratorError[TValue](iterable2, n)
+}